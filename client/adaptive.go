@@ -0,0 +1,166 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Adaptive concurrency defaults
+const (
+	defaultInitialLimit = 32   // Requests in flight before any feedback
+	defaultMinLimit     = 1    // Smallest limit after repeated throttling
+	defaultMaxLimit     = 1024 // Largest limit after sustained success
+	defaultBackoffRatio = 0.5  // Multiplicative decrease on throttling
+)
+
+// AdaptiveConcurrencyConfig enables an AIMD limit on the number of requests a client has in flight.
+// Each ResourceExhausted response multiplies the limit by BackoffRatio; each success adds roughly one
+// request to the limit per limit's worth of successes.
+type AdaptiveConcurrencyConfig struct {
+	// InitialLimit is the starting number of requests allowed in flight (default 32)
+	InitialLimit int
+	// MinLimit is the lowest the limit can go (default 1)
+	MinLimit int
+	// MaxLimit is the highest the limit can go (default 1024)
+	MaxLimit int
+	// BackoffRatio is the factor applied to the limit when the service throttles (default 0.5)
+	BackoffRatio float64
+}
+
+// adaptiveLimiter bounds the requests in flight with an additive-increase, multiplicative-decrease limit.
+type adaptiveLimiter struct {
+	config AdaptiveConcurrencyConfig
+
+	mu           sync.Mutex
+	limit        float64
+	inFlight     int
+	waiters      []chan struct{} // Requests waiting for a slot, in arrival order
+	lastDecrease time.Time
+}
+
+// newAdaptiveLimiter returns a limiter for config, or nil if config is nil.
+func newAdaptiveLimiter(config *AdaptiveConcurrencyConfig) *adaptiveLimiter {
+	if config == nil {
+		return nil
+	}
+	limiter := &adaptiveLimiter{config: *config}
+	if limiter.config.MinLimit <= 0 {
+		limiter.config.MinLimit = defaultMinLimit
+	}
+	if limiter.config.MaxLimit <= 0 {
+		limiter.config.MaxLimit = defaultMaxLimit
+	}
+	if limiter.config.InitialLimit <= 0 {
+		limiter.config.InitialLimit = defaultInitialLimit
+	}
+	if limiter.config.BackoffRatio <= 0 || limiter.config.BackoffRatio >= 1 {
+		limiter.config.BackoffRatio = defaultBackoffRatio
+	}
+	limiter.limit = float64(min(max(limiter.config.InitialLimit, limiter.config.MinLimit), limiter.config.MaxLimit))
+	return limiter
+}
+
+// acquire waits for a slot and returns the time the request started, which must be passed to release.
+func (limiter *adaptiveLimiter) acquire(ctx context.Context) (time.Time, error) {
+	if limiter == nil {
+		return time.Time{}, nil
+	}
+
+	limiter.mu.Lock()
+	if limiter.inFlight < int(limiter.limit) && len(limiter.waiters) == 0 {
+		limiter.inFlight++
+		limiter.mu.Unlock()
+		return time.Now(), nil
+	}
+	ready := make(chan struct{})
+	limiter.waiters = append(limiter.waiters, ready)
+	limiter.mu.Unlock()
+
+	select {
+	case <-ready:
+		return time.Now(), nil
+	case <-ctx.Done():
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+		for i, waiter := range limiter.waiters {
+			if waiter == ready {
+				limiter.waiters = append(limiter.waiters[:i], limiter.waiters[i+1:]...)
+				return time.Time{}, ctx.Err()
+			}
+		}
+		// The slot was granted concurrently; hand it on
+		limiter.inFlight--
+		limiter.grantLocked()
+		return time.Time{}, ctx.Err()
+	}
+}
+
+// tryAcquire takes a slot only if one is free without waiting, returning the time the request started
+// and whether it got the slot. A nil limiter always grants one.
+func (limiter *adaptiveLimiter) tryAcquire() (time.Time, bool) {
+	if limiter == nil {
+		return time.Time{}, true
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	if limiter.inFlight < int(limiter.limit) && len(limiter.waiters) == 0 {
+		limiter.inFlight++
+		return time.Now(), true
+	}
+	return time.Time{}, false
+}
+
+// release frees the slot of a request that started at started and adjusts the limit to its outcome.
+func (limiter *adaptiveLimiter) release(started time.Time, err error) {
+	if limiter == nil {
+		return
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	limiter.inFlight--
+	switch {
+	case status.Code(err) == codes.ResourceExhausted:
+		// Requests sent before the last decrease were throttled under the old limit; count them once
+		if started.After(limiter.lastDecrease) {
+			limiter.limit = max(limiter.limit*limiter.config.BackoffRatio, float64(limiter.config.MinLimit))
+			limiter.lastDecrease = time.Now()
+		}
+	case err == nil:
+		limiter.limit = min(limiter.limit+1/limiter.limit, float64(limiter.config.MaxLimit))
+	}
+	limiter.grantLocked()
+}
+
+// grantLocked hands free slots to waiting requests. The caller must hold mu.
+func (limiter *adaptiveLimiter) grantLocked() {
+	for len(limiter.waiters) > 0 && limiter.inFlight < int(limiter.limit) {
+		limiter.inFlight++
+		close(limiter.waiters[0])
+		limiter.waiters = limiter.waiters[1:]
+	}
+}
+
+// state returns the current limit and the number of requests in flight.
+func (limiter *adaptiveLimiter) state() (int, int) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+	return int(limiter.limit), limiter.inFlight
+}
+
+// ConcurrencyLimit returns the adaptive concurrency limit and the number of requests in flight.
+// Both are zero if adaptive concurrency is not configured.
+func (client *ACSClient) ConcurrencyLimit() (limit, inFlight int) {
+	if client.retry.limiter == nil {
+		return 0, 0
+	}
+	return client.retry.limiter.state()
+}
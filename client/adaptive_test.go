@@ -0,0 +1,172 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNewAdaptiveLimiter(t *testing.T) {
+	if limiter := newAdaptiveLimiter(nil); limiter != nil {
+		t.Errorf("newAdaptiveLimiter(nil) = %+v, want nil", limiter)
+	}
+
+	tests := []struct {
+		name      string
+		config    AdaptiveConcurrencyConfig
+		wantLimit int
+	}{
+		{name: "defaults", config: AdaptiveConcurrencyConfig{}, wantLimit: defaultInitialLimit},
+		{name: "initial", config: AdaptiveConcurrencyConfig{InitialLimit: 4}, wantLimit: 4},
+		{name: "below minimum", config: AdaptiveConcurrencyConfig{InitialLimit: 2, MinLimit: 8}, wantLimit: 8},
+		{name: "above maximum", config: AdaptiveConcurrencyConfig{InitialLimit: 64, MaxLimit: 16}, wantLimit: 16},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := newAdaptiveLimiter(&tt.config)
+			if limit, inFlight := limiter.state(); limit != tt.wantLimit || inFlight != 0 {
+				t.Errorf("state() = %d, %d; want %d, 0", limit, inFlight, tt.wantLimit)
+			}
+		})
+	}
+
+	limiter := newAdaptiveLimiter(&AdaptiveConcurrencyConfig{BackoffRatio: 1.5})
+	if limiter.config.BackoffRatio != defaultBackoffRatio {
+		t.Errorf("BackoffRatio = %v, want a ratio that does not shrink the limit replaced by %v", limiter.config.BackoffRatio, defaultBackoffRatio)
+	}
+}
+
+func TestAdaptiveLimiterIncrease(t *testing.T) {
+	limiter := newAdaptiveLimiter(&AdaptiveConcurrencyConfig{InitialLimit: 4, MaxLimit: 6})
+
+	// succeed completes n requests successfully and returns the resulting limit
+	succeed := func(n int) int {
+		for i := 0; i < n; i++ {
+			started, err := limiter.acquire(context.Background())
+			if err != nil {
+				t.Fatalf("acquire() error = %v", err)
+			}
+			limiter.release(started, nil)
+		}
+		limit, _ := limiter.state()
+		return limit
+	}
+
+	// A limit's worth of successes adds about one request
+	if limit := succeed(4); limit != 4 {
+		t.Errorf("limit after 4 successes = %d, want 4", limit)
+	}
+	if limit := succeed(4); limit != 5 {
+		t.Errorf("limit after 8 successes = %d, want 5", limit)
+	}
+	if limit := succeed(100); limit != 6 {
+		t.Errorf("limit after sustained success = %d, want the maximum of 6", limit)
+	}
+
+	// Errors other than throttling leave the limit alone
+	started, _ := limiter.acquire(context.Background())
+	limiter.release(started, status.Error(codes.NotFound, "no such key"))
+	if limit, inFlight := limiter.state(); limit != 6 || inFlight != 0 {
+		t.Errorf("state() after a NotFound = %d, %d; want 6, 0", limit, inFlight)
+	}
+}
+
+func TestAdaptiveLimiterDecrease(t *testing.T) {
+	limiter := newAdaptiveLimiter(&AdaptiveConcurrencyConfig{InitialLimit: 16, MinLimit: 3})
+	throttled := status.Error(codes.ResourceExhausted, "slow down")
+
+	// Requests throttled together under the old limit only decrease it once
+	first, _ := limiter.acquire(context.Background())
+	second, _ := limiter.acquire(context.Background())
+	limiter.release(first, throttled)
+	limiter.release(second, throttled)
+	if limit, _ := limiter.state(); limit != 8 {
+		t.Errorf("limit after one burst of throttling = %d, want 8", limit)
+	}
+
+	// A request sent after the decrease that is throttled again decreases it further, down to the minimum
+	for _, want := range []int{4, 3, 3} {
+		time.Sleep(time.Millisecond)
+		started, _ := limiter.acquire(context.Background())
+		limiter.release(started, throttled)
+		if limit, _ := limiter.state(); limit != want {
+			t.Errorf("limit after further throttling = %d, want %d", limit, want)
+		}
+	}
+}
+
+func TestAdaptiveLimiterWaiters(t *testing.T) {
+	limiter := newAdaptiveLimiter(&AdaptiveConcurrencyConfig{InitialLimit: 1, MaxLimit: 1})
+	started, err := limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+	if _, ok := limiter.tryAcquire(); ok {
+		t.Error("tryAcquire() got a slot while the limit was reached")
+	}
+
+	// A waiter that gives up does not hold on to a slot
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("acquire() over the limit error = %v, want DeadlineExceeded", err)
+	}
+
+	// Waiters are granted slots in arrival order as requests finish
+	order := make(chan int, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			started, err := limiter.acquire(context.Background())
+			if err != nil {
+				t.Errorf("acquire() error = %v", err)
+				return
+			}
+			order <- i
+			limiter.release(started, nil)
+		}()
+		// Let the waiter queue before the next one arrives
+		for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+			limiter.mu.Lock()
+			waiting := len(limiter.waiters)
+			limiter.mu.Unlock()
+			if waiting == i+1 || time.Now().After(deadline) {
+				break
+			}
+		}
+	}
+	limiter.release(started, nil)
+	if first, second := <-order, <-order; first != 0 || second != 1 {
+		t.Errorf("waiters granted in order %d, %d; want 0, 1", first, second)
+	}
+	if _, inFlight := limiter.state(); inFlight != 0 {
+		t.Errorf("requests in flight after all finished = %d, want 0", inFlight)
+	}
+}
+
+func TestConcurrencyLimit(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("object", []byte("data"))
+	throttle := grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return nil, status.Error(codes.ResourceExhausted, "slow down")
+	})
+	client := newTestClientWith(t, storage, []grpc.ServerOption{throttle})
+	if limit, inFlight := client.ConcurrencyLimit(); limit != 0 || inFlight != 0 {
+		t.Errorf("ConcurrencyLimit() without adaptive concurrency = %d, %d; want 0, 0", limit, inFlight)
+	}
+
+	client.retry.limiter = newAdaptiveLimiter(&AdaptiveConcurrencyConfig{InitialLimit: 64})
+	if _, err := client.HeadObject(context.Background(), "bucket", "object"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("HeadObject() error = %v, want ResourceExhausted", err)
+	}
+
+	// Every retry was sent after the previous decrease, so each one halved the limit
+	if limit, inFlight := client.ConcurrencyLimit(); limit != 64>>DefaultRetryConfig.MaxAttempts || inFlight != 0 {
+		t.Errorf("ConcurrencyLimit() after throttling = %d, %d; want %d, 0", limit, inFlight, 64>>DefaultRetryConfig.MaxAttempts)
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Batch constants
+const (
+	maxBatchKeys            = 1000 // Keys sent in a single batch request
+	defaultBatchConcurrency = 32   // Individual requests in flight when the service does not support batches
+)
+
+// HeadObjectsResult is the outcome of HeadObjects for one key.
+type HeadObjectsResult struct {
+	Key    string
+	Output *HeadObjectOutput // Set if the key was found
+	Err    error             // Set if the metadata could not be retrieved
+}
+
+// GetObjectsResult is the outcome of GetObjects for one key.
+type GetObjectsResult struct {
+	Key  string
+	Data []byte // Set if the object was retrieved
+	Err  error  // Set if the object could not be retrieved
+}
+
+// HeadObjects retrieves the metadata of many objects in a bucket with as few requests as possible.
+// It returns one result per key, in the order of keys; per-key failures such as NotFound are reported in the
+// result rather than as an error. If the service does not support batch requests, the keys are fetched
+// with individual HeadObject calls with bounded concurrency.
+// It returns an error only if the whole operation fails.
+func (client *ACSClient) HeadObjects(ctx context.Context, bucket string, keys []string) ([]HeadObjectsResult, error) {
+	results := make([]HeadObjectsResult, len(keys))
+	for i, key := range keys {
+		results[i].Key = key
+	}
+	done := make([]bool, len(keys))
+
+	if !client.batchUnsupported.Load() {
+		err := forEachBatch(keys, func(start, end int) error {
+			return client.headObjectsBatch(ctx, bucket, results[start:end], done[start:end])
+		})
+		if err != nil && !client.markBatchUnsupported(err) {
+			return nil, err
+		}
+	}
+
+	// Fall back to individual requests for whatever the batch requests did not answer
+	fanOut(ctx, done, func(i int) {
+		results[i].Output, results[i].Err = client.HeadObject(ctx, bucket, keys[i])
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// headObjectsBatch retrieves the metadata of one batch of keys, retrying only the keys not yet answered.
+func (client *ACSClient) headObjectsBatch(ctx context.Context, bucket string, results []HeadObjectsResult, done []bool) error {
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		pending, keys := pendingKeys(results, done, func(result HeadObjectsResult) string { return result.Key })
+		if len(keys) == 0 {
+			return nil
+		}
+
+		req := &pb.HeadObjectsRequest{
+			Bucket: bucket,
+			Keys:   keys,
+		}
+		stream, err := client.client.HeadObjects(withSigningResource(ctx, bucket, ""), req)
+		if err != nil {
+			return fmt.Errorf("failed to start HeadObjects stream: %w", err)
+		}
+
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("error receiving object metadata: %w", err)
+			}
+
+			var output *HeadObjectOutput
+			var keyErr error
+			switch result := resp.Result.(type) {
+			case *pb.HeadObjectsResponse_Metadata:
+				output = headObjectOutput(result.Metadata)
+			case *pb.HeadObjectsResponse_Error:
+				keyErr = keyError(result.Error)
+			default:
+				return fmt.Errorf("missing result for key %q", resp.Key)
+			}
+			for _, i := range pending[resp.Key] {
+				results[i].Output, results[i].Err, done[i] = output, keyErr, true
+			}
+			delete(pending, resp.Key)
+		}
+
+		// The service must answer every key it was asked for
+		for key, indexes := range pending {
+			for _, i := range indexes {
+				results[i].Err, done[i] = fmt.Errorf("no metadata returned for key %q", key), true
+			}
+		}
+		return nil
+	})
+}
+
+// GetObjects retrieves many objects from a bucket with as few requests as possible.
+// It returns one result per key, in the order of keys; per-key failures such as NotFound are reported in the
+// result rather than as an error. If the service does not support batch requests, the objects are fetched
+// with individual GetObject calls with bounded concurrency.
+// It returns an error only if the whole operation fails.
+func (client *ACSClient) GetObjects(ctx context.Context, bucket string, keys []string) ([]GetObjectsResult, error) {
+	results := make([]GetObjectsResult, len(keys))
+	for i, key := range keys {
+		results[i].Key = key
+	}
+	done := make([]bool, len(keys))
+
+	if !client.batchUnsupported.Load() {
+		err := forEachBatch(keys, func(start, end int) error {
+			return client.getObjectsBatch(ctx, bucket, results[start:end], done[start:end])
+		})
+		if err != nil && !client.markBatchUnsupported(err) {
+			return nil, err
+		}
+	}
+
+	// Fall back to individual requests for whatever the batch requests did not answer
+	fanOut(ctx, done, func(i int) {
+		results[i].Data, results[i].Err = client.GetObject(ctx, bucket, keys[i])
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// getObjectsBatch retrieves one batch of objects, retrying only the objects not yet received in full.
+func (client *ACSClient) getObjectsBatch(ctx context.Context, bucket string, results []GetObjectsResult, done []bool) error {
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		pending, keys := pendingKeys(results, done, func(result GetObjectsResult) string { return result.Key })
+		if len(keys) == 0 {
+			return nil
+		}
+
+		req := &pb.GetObjectsRequest{
+			Bucket: bucket,
+			Keys:   keys,
+		}
+		stream, err := client.client.GetObjects(withSigningResource(ctx, bucket, ""), req)
+		if err != nil {
+			return fmt.Errorf("failed to start GetObjects stream: %w", err)
+		}
+
+		// Objects arrive one after another as a metadata message followed by their chunks
+		var current string
+		var isCompressed bool
+		buf := bytes.NewBuffer(make([]byte, 0, 256*1024))
+		finish := func(data []byte, keyErr error) {
+			for _, i := range pending[current] {
+				results[i].Data, results[i].Err, done[i] = data, keyErr, true
+			}
+			delete(pending, current)
+			current = ""
+		}
+		finishCurrent := func() {
+			if current == "" {
+				return
+			}
+			data := bytes.Clone(buf.Bytes())
+			if isCompressed {
+				var err error
+				if data, err = decompressData(data); err != nil {
+					finish(nil, err)
+					return
+				}
+			}
+			finish(data, nil)
+		}
+
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("error receiving objects: %w", err)
+			}
+
+			switch data := resp.Data.(type) {
+			case *pb.GetObjectsResponse_Metadata:
+				finishCurrent()
+				current, isCompressed = resp.Key, data.Metadata.GetIsCompressed()
+				buf.Reset()
+			case *pb.GetObjectsResponse_Chunk:
+				if resp.Key != current {
+					return fmt.Errorf("chunk for key %q received without its metadata", resp.Key)
+				}
+				if err := client.limits.download.wait(ctx, len(data.Chunk)); err != nil {
+					return err
+				}
+				buf.Write(data.Chunk)
+			case *pb.GetObjectsResponse_Error:
+				finishCurrent()
+				current = resp.Key
+				finish(nil, keyError(data.Error))
+			default:
+				return fmt.Errorf("missing data for key %q", resp.Key)
+			}
+		}
+		finishCurrent()
+
+		// The service must answer every key it was asked for
+		for key, indexes := range pending {
+			for _, i := range indexes {
+				results[i].Err, done[i] = fmt.Errorf("no data returned for key %q", key), true
+			}
+		}
+		return nil
+	})
+}
+
+// markBatchUnsupported reports whether err shows the service does not implement batch requests,
+// remembering it so later calls go straight to individual requests.
+func (client *ACSClient) markBatchUnsupported(err error) bool {
+	if status.Code(err) != codes.Unimplemented {
+		return false
+	}
+	client.batchUnsupported.Store(true)
+	return true
+}
+
+// keyError converts a per-key error from a batch response to a gRPC status error.
+func keyError(keyErr *pb.KeyError) error {
+	return status.Error(codes.Code(keyErr.GetCode()), keyErr.GetMessage())
+}
+
+// forEachBatch calls fn with the bounds of consecutive batches of at most maxBatchKeys keys.
+func forEachBatch(keys []string, fn func(start, end int) error) error {
+	for start := 0; start < len(keys); start += maxBatchKeys {
+		if err := fn(start, min(start+maxBatchKeys, len(keys))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pendingKeys maps every key not yet done to the indexes of its results, and returns the distinct keys in order.
+// A key may be requested more than once.
+func pendingKeys[T any](results []T, done []bool, keyOf func(T) string) (map[string][]int, []string) {
+	pending := make(map[string][]int)
+	var keys []string
+	for i, result := range results {
+		if done[i] {
+			continue
+		}
+		key := keyOf(result)
+		if _, ok := pending[key]; !ok {
+			keys = append(keys, key)
+		}
+		pending[key] = append(pending[key], i)
+	}
+	return pending, keys
+}
+
+// fanOut calls fn for the index of every entry of done that is false, with bounded concurrency.
+// It stops handing out indexes once ctx is done.
+func fanOut(ctx context.Context, done []bool, fn func(i int)) {
+	remaining := 0
+	for _, finished := range done {
+		if !finished {
+			remaining++
+		}
+	}
+
+	work := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < min(defaultBatchConcurrency, remaining); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range work {
+				fn(index)
+			}
+		}()
+	}
+
+	for index, finished := range done {
+		if finished {
+			continue
+		}
+		select {
+		case work <- index:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(work)
+	wg.Wait()
+}
@@ -0,0 +1,172 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// batchStorage is a fakeStorage that also serves batch requests, recording the keys of each one.
+type batchStorage struct {
+	*fakeStorage
+	requests [][]string
+}
+
+func (s *batchStorage) record(keys []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, keys)
+}
+
+func (s *batchStorage) HeadObjects(req *pb.HeadObjectsRequest, stream pb.ObjectStorageCache_HeadObjectsServer) error {
+	s.record(req.Keys)
+	for _, key := range req.Keys {
+		resp := &pb.HeadObjectsResponse{Key: key}
+		if obj, err := s.lookup("HeadObjects", key); err != nil {
+			resp.Result = &pb.HeadObjectsResponse_Error{Error: &pb.KeyError{Code: int32(status.Code(err)), Message: err.Error()}}
+		} else {
+			resp.Result = &pb.HeadObjectsResponse_Metadata{Metadata: &pb.ObjectMetadata{Size: int64(len(obj.data)), Etag: obj.etag}}
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *batchStorage) GetObjects(req *pb.GetObjectsRequest, stream pb.ObjectStorageCache_GetObjectsServer) error {
+	s.record(req.Keys)
+	for _, key := range req.Keys {
+		obj, err := s.lookup("GetObjects", key)
+		if err != nil {
+			resp := &pb.GetObjectsResponse{Key: key, Data: &pb.GetObjectsResponse_Error{Error: &pb.KeyError{Code: int32(status.Code(err)), Message: err.Error()}}}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := stream.Send(&pb.GetObjectsResponse{Key: key, Data: &pb.GetObjectsResponse_Metadata{Metadata: &pb.GetObjectMetadata{}}}); err != nil {
+			return err
+		}
+		for data := obj.data; len(data) > 0; {
+			n := min(len(data), 1024)
+			if err := stream.Send(&pb.GetObjectsResponse{Key: key, Data: &pb.GetObjectsResponse_Chunk{Chunk: data[:n]}}); err != nil {
+				return err
+			}
+			data = data[n:]
+		}
+	}
+	return nil
+}
+
+// countBatches counts the batch streams that reach the server, whether or not it implements them.
+func countBatches(count *atomic.Int32) grpc.ServerOption {
+	return grpc.StreamInterceptor(func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		switch info.FullMethod {
+		case pb.ObjectStorageCache_HeadObjects_FullMethodName, pb.ObjectStorageCache_GetObjects_FullMethodName:
+			count.Add(1)
+		}
+		return handler(srv, stream)
+	})
+}
+
+func TestHeadObjectsBatch(t *testing.T) {
+	storage := &batchStorage{fakeStorage: newFakeStorage()}
+	storage.put("a", testData(10, 1))
+	storage.put("b", testData(20, 2))
+	client := newTestClient(t, storage)
+
+	// A key requested twice is only sent once and answers both results
+	results, err := client.HeadObjects(context.Background(), "bucket", []string{"a", "b", "a", "missing"})
+	if err != nil {
+		t.Fatalf("HeadObjects() error = %v", err)
+	}
+	if want := [][]string{{"a", "b", "missing"}}; !reflect.DeepEqual(storage.requests, want) {
+		t.Errorf("batch requests = %v, want %v", storage.requests, want)
+	}
+	for i, want := range []struct {
+		key  string
+		size int64
+		code codes.Code
+	}{{"a", 10, codes.OK}, {"b", 20, codes.OK}, {"a", 10, codes.OK}, {"missing", 0, codes.NotFound}} {
+		result := results[i]
+		if result.Key != want.key || status.Code(result.Err) != want.code {
+			t.Errorf("result %d = %q, %v; want %q, %v", i, result.Key, result.Err, want.key, want.code)
+		}
+		if want.code == codes.OK && (result.Output == nil || result.Output.ContentLength != want.size) {
+			t.Errorf("result %d output = %+v, want size %d", i, result.Output, want.size)
+		}
+	}
+	if got := storage.called("HeadObject"); got != 0 {
+		t.Errorf("HeadObject calls = %d, want none", got)
+	}
+}
+
+func TestGetObjectsBatch(t *testing.T) {
+	storage := &batchStorage{fakeStorage: newFakeStorage()}
+	a, b := testData(3000, 1), testData(10, 2)
+	storage.put("a", a)
+	storage.put("b", b)
+	client := newTestClient(t, storage)
+
+	results, err := client.GetObjects(context.Background(), "bucket", []string{"a", "missing", "b", "a"})
+	if err != nil {
+		t.Fatalf("GetObjects() error = %v", err)
+	}
+	if want := [][]string{{"a", "missing", "b"}}; !reflect.DeepEqual(storage.requests, want) {
+		t.Errorf("batch requests = %v, want %v", storage.requests, want)
+	}
+	for i, want := range [][]byte{a, nil, b, a} {
+		if results[i].Err != nil && want != nil {
+			t.Errorf("result %d error = %v", i, results[i].Err)
+		}
+		if !bytes.Equal(results[i].Data, want) {
+			t.Errorf("result %d holds %d bytes, want %d", i, len(results[i].Data), len(want))
+		}
+	}
+	if status.Code(results[1].Err) != codes.NotFound {
+		t.Errorf("result for a missing key error = %v, want NotFound", results[1].Err)
+	}
+	if got := storage.called("GetObject"); got != 0 {
+		t.Errorf("GetObject calls = %d, want none", got)
+	}
+}
+
+func TestBatchFallsBackWhenUnimplemented(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("a", testData(10, 1))
+	var batches atomic.Int32
+	client := newTestClientWith(t, storage, []grpc.ServerOption{countBatches(&batches)})
+	keys := []string{"a", "missing", "a"}
+
+	heads, err := client.HeadObjects(context.Background(), "bucket", keys)
+	if err != nil {
+		t.Fatalf("HeadObjects() error = %v", err)
+	}
+	if heads[0].Output == nil || heads[2].Output == nil || status.Code(heads[1].Err) != codes.NotFound {
+		t.Errorf("HeadObjects() results = %+v, want a found twice and missing not found", heads)
+	}
+	if got := storage.called("HeadObject"); got != len(keys) {
+		t.Errorf("HeadObject calls = %d, want one per key", got)
+	}
+
+	// Once the service has shown it does not support batches, no more batch requests are sent
+	objects, err := client.GetObjects(context.Background(), "bucket", keys)
+	if err != nil {
+		t.Fatalf("GetObjects() error = %v", err)
+	}
+	if !bytes.Equal(objects[0].Data, testData(10, 1)) || !bytes.Equal(objects[2].Data, testData(10, 1)) || status.Code(objects[1].Err) != codes.NotFound {
+		t.Errorf("GetObjects() results = %+v, want a found twice and missing not found", objects)
+	}
+	if got := batches.Load(); got != 1 {
+		t.Errorf("batch requests = %d, want only the first", got)
+	}
+}
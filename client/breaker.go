@@ -0,0 +1,196 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Circuit breaker defaults
+const (
+	defaultFailureThreshold = 5                // Consecutive failures that open the circuit
+	defaultOpenTimeout      = 30 * time.Second // Time the circuit stays open before probing
+	defaultHalfOpenRequests = 1                // Probe requests allowed while half-open
+)
+
+// ErrCircuitOpen is returned without contacting the service while the circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets every request through
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails every request with ErrCircuitOpen
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of probe requests through to test whether the service has recovered
+	CircuitHalfOpen
+)
+
+// String returns the name of the state.
+func (state CircuitState) String() string {
+	switch state {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreakerConfig enables a circuit breaker in the retry layer.
+// Consecutive Unavailable or DeadlineExceeded failures open the circuit; any other response closes it.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures that opens the circuit (default 5)
+	FailureThreshold int
+	// OpenTimeout is how long the circuit stays open before allowing probe requests (default 30s)
+	OpenTimeout time.Duration
+	// HalfOpenRequests is the number of concurrent probe requests allowed while half-open (default 1)
+	HalfOpenRequests int
+	// OnStateChange is called after every state change, outside of any lock
+	OnStateChange func(from, to CircuitState)
+}
+
+// circuitBreaker tracks consecutive failures and rejects requests while the service appears down.
+type circuitBreaker struct {
+	config CircuitBreakerConfig
+
+	mu       sync.Mutex
+	state    CircuitState
+	failures int       // Consecutive failures while closed
+	openedAt time.Time // When the circuit last opened
+	probes   int       // Probe requests in flight while half-open
+}
+
+// newCircuitBreaker returns a breaker for config, or nil if config is nil.
+func newCircuitBreaker(config *CircuitBreakerConfig) *circuitBreaker {
+	if config == nil {
+		return nil
+	}
+	breaker := &circuitBreaker{config: *config}
+	if breaker.config.FailureThreshold <= 0 {
+		breaker.config.FailureThreshold = defaultFailureThreshold
+	}
+	if breaker.config.OpenTimeout <= 0 {
+		breaker.config.OpenTimeout = defaultOpenTimeout
+	}
+	if breaker.config.HalfOpenRequests <= 0 {
+		breaker.config.HalfOpenRequests = defaultHalfOpenRequests
+	}
+	return breaker
+}
+
+// isServiceFailure reports whether err indicates the service is unreachable or not responding.
+func isServiceFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// currentState returns the state of the breaker; a nil breaker is always closed.
+func (breaker *circuitBreaker) currentState() CircuitState {
+	if breaker == nil {
+		return CircuitClosed
+	}
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+	return breaker.state
+}
+
+// allow reports whether a request may be sent, returning ErrCircuitOpen if not, and whether it was
+// admitted as a half-open probe. Every allowed request must be followed by a call to record.
+func (breaker *circuitBreaker) allow() (bool, error) {
+	if breaker == nil {
+		return false, nil
+	}
+
+	breaker.mu.Lock()
+	from := breaker.state
+	if breaker.state == CircuitOpen && time.Since(breaker.openedAt) >= breaker.config.OpenTimeout {
+		breaker.state = CircuitHalfOpen
+		breaker.probes = 0
+	}
+
+	var err error
+	probe := false
+	switch breaker.state {
+	case CircuitOpen:
+		err = ErrCircuitOpen
+	case CircuitHalfOpen:
+		if breaker.probes >= breaker.config.HalfOpenRequests {
+			err = ErrCircuitOpen
+		} else {
+			breaker.probes++
+			probe = true
+		}
+	}
+	to := breaker.state
+	breaker.mu.Unlock()
+
+	breaker.notify(from, to)
+	return probe, err
+}
+
+// record updates the breaker with the outcome of an allowed request made with ctx, where probe is
+// what allow returned for it. Only probes decide the state while half-open, and a request admitted
+// before the circuit opened says nothing about whether the service has recovered since.
+// A request whose own context was cancelled or ran out of time says nothing about the service, so a
+// DeadlineExceeded caused by the caller's short deadline is not counted as a failure.
+func (breaker *circuitBreaker) record(ctx context.Context, probe bool, err error) {
+	if breaker == nil {
+		return
+	}
+
+	breaker.mu.Lock()
+	from := breaker.state
+	if probe && breaker.state == CircuitHalfOpen && breaker.probes > 0 {
+		breaker.probes--
+	}
+
+	current := (breaker.state == CircuitClosed && !probe) || (breaker.state == CircuitHalfOpen && probe)
+	switch {
+	case !current:
+		// Admitted in an earlier state
+	case err != nil && (ctx.Err() != nil || status.Code(err) == codes.Canceled || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)):
+		// The caller gave up; this says nothing about the service
+	case isServiceFailure(err):
+		breaker.failures++
+		if breaker.state == CircuitHalfOpen || breaker.failures >= breaker.config.FailureThreshold {
+			breaker.state = CircuitOpen
+			breaker.openedAt = time.Now()
+		}
+	default:
+		// The service answered, even if with an error
+		breaker.failures = 0
+		breaker.state = CircuitClosed
+	}
+	to := breaker.state
+	breaker.mu.Unlock()
+
+	breaker.notify(from, to)
+}
+
+// notify reports a state change to the configured callback.
+func (breaker *circuitBreaker) notify(from, to CircuitState) {
+	if from != to && breaker.config.OnStateChange != nil {
+		breaker.config.OnStateChange(from, to)
+	}
+}
+
+// CircuitState returns the state of the client's circuit breaker; it is always CircuitClosed if none is configured.
+func (client *ACSClient) CircuitState() CircuitState {
+	return client.retry.breaker.currentState()
+}
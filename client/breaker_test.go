@@ -0,0 +1,160 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBreakerIgnoresCallerDeadlines(t *testing.T) {
+	breaker := newCircuitBreaker(&CircuitBreakerConfig{FailureThreshold: 1})
+	deadlineExceeded := status.Error(codes.DeadlineExceeded, "context deadline exceeded")
+
+	// A deadline the caller set too short for the request is not the service's fault
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+	if _, err := breaker.allow(); err != nil {
+		t.Fatalf("allow() error = %v", err)
+	}
+	breaker.record(ctx, false, deadlineExceeded)
+	if state := breaker.currentState(); state != CircuitClosed {
+		t.Errorf("state after the caller's deadline expired = %v, want %v", state, CircuitClosed)
+	}
+
+	// The same error while the caller is still waiting means the service did not respond in time
+	if _, err := breaker.allow(); err != nil {
+		t.Fatalf("allow() error = %v", err)
+	}
+	breaker.record(context.Background(), false, deadlineExceeded)
+	if state := breaker.currentState(); state != CircuitOpen {
+		t.Errorf("state after a service timeout = %v, want %v", state, CircuitOpen)
+	}
+}
+
+var errUnavailable = status.Error(codes.Unavailable, "unavailable")
+
+// testBreaker returns a breaker whose circuit opens after two failures and admits two probes.
+func testBreaker(transitions *[]string) *circuitBreaker {
+	return newCircuitBreaker(&CircuitBreakerConfig{
+		FailureThreshold: 2,
+		OpenTimeout:      time.Hour,
+		HalfOpenRequests: 2,
+		OnStateChange: func(from, to CircuitState) {
+			*transitions = append(*transitions, from.String()+"->"+to.String())
+		},
+	})
+}
+
+// attempt admits a request and records err as its outcome.
+func attempt(t *testing.T, breaker *circuitBreaker, err error) {
+	t.Helper()
+	probe, allowErr := breaker.allow()
+	if allowErr != nil {
+		t.Fatalf("allow() error = %v", allowErr)
+	}
+	breaker.record(context.Background(), probe, err)
+}
+
+// expireOpenTimeout makes an open circuit ready to probe.
+func expireOpenTimeout(breaker *circuitBreaker) {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+	breaker.openedAt = time.Now().Add(-breaker.config.OpenTimeout)
+}
+
+func TestBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	var transitions []string
+	breaker := testBreaker(&transitions)
+
+	// Any response from the service resets the count of consecutive failures
+	attempt(t, breaker, errUnavailable)
+	attempt(t, breaker, status.Error(codes.NotFound, "no such key"))
+	attempt(t, breaker, errUnavailable)
+	if state := breaker.currentState(); state != CircuitClosed {
+		t.Fatalf("state after non-consecutive failures = %v, want %v", state, CircuitClosed)
+	}
+
+	attempt(t, breaker, errUnavailable)
+	if state := breaker.currentState(); state != CircuitOpen {
+		t.Fatalf("state after consecutive failures = %v, want %v", state, CircuitOpen)
+	}
+	if _, err := breaker.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("allow() while open error = %v, want ErrCircuitOpen", err)
+	}
+	if want := []string{"closed->open"}; !reflect.DeepEqual(transitions, want) {
+		t.Errorf("transitions = %v, want %v", transitions, want)
+	}
+}
+
+func TestBreakerHalfOpenProbes(t *testing.T) {
+	var transitions []string
+	breaker := testBreaker(&transitions)
+
+	// A request admitted while closed is still in flight when the circuit opens
+	lateProbe, err := breaker.allow()
+	if err != nil || lateProbe {
+		t.Fatalf("allow() = %v, %v; want a request admitted while closed", lateProbe, err)
+	}
+	attempt(t, breaker, errUnavailable)
+	attempt(t, breaker, errUnavailable)
+	expireOpenTimeout(breaker)
+
+	// Only two probes are admitted once the open timeout has passed
+	for i := 0; i < 2; i++ {
+		if probe, err := breaker.allow(); err != nil || !probe {
+			t.Fatalf("allow() of probe %d = %v, %v; want a probe", i+1, probe, err)
+		}
+	}
+	if state := breaker.currentState(); state != CircuitHalfOpen {
+		t.Fatalf("state after the open timeout = %v, want %v", state, CircuitHalfOpen)
+	}
+	if _, err := breaker.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("allow() beyond the probe limit error = %v, want ErrCircuitOpen", err)
+	}
+
+	// The request admitted while closed neither closes the circuit nor frees a probe slot
+	breaker.record(context.Background(), false, nil)
+	if state := breaker.currentState(); state != CircuitHalfOpen {
+		t.Fatalf("state after a request admitted while closed succeeded = %v, want %v", state, CircuitHalfOpen)
+	}
+	if _, err := breaker.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("allow() after a non-probe completed error = %v, want ErrCircuitOpen", err)
+	}
+
+	// A successful probe closes the circuit
+	breaker.record(context.Background(), true, nil)
+	if state := breaker.currentState(); state != CircuitClosed {
+		t.Fatalf("state after a successful probe = %v, want %v", state, CircuitClosed)
+	}
+	want := []string{"closed->open", "open->half-open", "half-open->closed"}
+	if !reflect.DeepEqual(transitions, want) {
+		t.Errorf("transitions = %v, want %v", transitions, want)
+	}
+}
+
+func TestBreakerFailedProbeReopens(t *testing.T) {
+	var transitions []string
+	breaker := testBreaker(&transitions)
+	attempt(t, breaker, errUnavailable)
+	attempt(t, breaker, errUnavailable)
+	expireOpenTimeout(breaker)
+
+	attempt(t, breaker, errUnavailable)
+	if state := breaker.currentState(); state != CircuitOpen {
+		t.Fatalf("state after a failed probe = %v, want %v", state, CircuitOpen)
+	}
+	if _, err := breaker.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("allow() after a failed probe error = %v, want ErrCircuitOpen until the timeout passes again", err)
+	}
+	want := []string{"closed->open", "open->half-open", "half-open->open"}
+	if !reflect.DeepEqual(transitions, want) {
+		t.Errorf("transitions = %v, want %v", transitions, want)
+	}
+}
@@ -0,0 +1,448 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AcceleratedCloudStorage/acs-sdk-go/internal/byterange"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Cache constants
+const (
+	defaultCacheBlockSize = 8 * 1024 * 1024 // 8MB blocks
+	cacheEvictionTarget   = 0.9             // Evict down to 90% of the size cap
+)
+
+// errStaleCacheEntry reports that an object changed on the server after its cached metadata was validated.
+var errStaleCacheEntry = errors.New("cached object changed on the server")
+
+// CacheConfig defines the configuration for the on-disk read cache.
+// Cached data is keyed by bucket, key and ETag, so a changed object is never served stale
+// once it has been revalidated.
+type CacheConfig struct {
+	// Dir is the directory holding cached data. It may be shared by several processes.
+	Dir string
+	// MaxSize is the maximum number of bytes of object data kept in the cache.
+	MaxSize int64
+	// BlockSize is the size of the blocks objects are cached in (default 8MB).
+	BlockSize int64
+	// TTL is how long object metadata is trusted before it is revalidated with HeadObject.
+	// If zero, every GetObject revalidates the object.
+	TTL time.Duration
+}
+
+// objectCache stores object data in fixed-size blocks on disk and evicts the least recently used blocks.
+// Blocks are written to a temporary file and renamed into place, and file locks coordinate processes
+// filling the same object or evicting concurrently.
+type objectCache struct {
+	config CacheConfig
+
+	mu   sync.Mutex
+	size int64
+}
+
+// cacheEntry is the metadata stored for a cached object.
+type cacheEntry struct {
+	Bucket    string    `json:"bucket"`
+	Key       string    `json:"key"`
+	ETag      string    `json:"etag"`
+	Size      int64     `json:"size"`
+	Validated time.Time `json:"validated"`
+}
+
+// newObjectCache creates the cache directory layout and measures the data already cached.
+func newObjectCache(config CacheConfig) (*objectCache, error) {
+	if config.Dir == "" {
+		return nil, fmt.Errorf("cache directory must be set")
+	}
+	if config.MaxSize <= 0 {
+		return nil, fmt.Errorf("cache size must be positive")
+	}
+	if config.BlockSize <= 0 {
+		config.BlockSize = defaultCacheBlockSize
+	}
+
+	cache := &objectCache{config: config}
+	for _, dir := range []string{cache.blocksDir(), cache.metaDir(), cache.locksDir()} {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, fmt.Errorf("failed to create cache directory: %v", err)
+		}
+	}
+
+	files, err := cache.blockFiles()
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		cache.size += file.size
+	}
+
+	return cache, nil
+}
+
+// get serves a GetObject request from cached blocks, downloading missing blocks from the server.
+// Blocks are only filled from the version the metadata describes; if the object changes while it is read,
+// the metadata is revalidated and the read starts over so blocks of different versions are never mixed.
+func (c *objectCache) get(ctx context.Context, client *ACSClient, bucket, key string, opts *GetObjectOptions) ([]byte, error) {
+	data, err := c.read(ctx, client, bucket, key, opts, false)
+	if errors.Is(err, errStaleCacheEntry) {
+		data, err = c.read(ctx, client, bucket, key, opts, true)
+	}
+	return data, err
+}
+
+// read assembles the requested range from the blocks of the object's current cache entry.
+func (c *objectCache) read(ctx context.Context, client *ACSClient, bucket, key string, opts *GetObjectOptions, revalidate bool) ([]byte, error) {
+	entry, err := c.entry(ctx, client, bucket, key, revalidate)
+	if err != nil {
+		return nil, err
+	}
+	if entry.Size == 0 {
+		return []byte{}, nil
+	}
+
+	start, end, err := parseRangeSpec(opts.rangeSpec, entry.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]byte, 0, end-start+1)
+	blockSize := c.config.BlockSize
+	for index := start / blockSize; index <= end/blockSize; index++ {
+		block, err := c.block(ctx, client, entry, index, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		blockStart := index * blockSize
+		lo := max(start, blockStart) - blockStart
+		hi := min(end, blockStart+int64(len(block))-1) - blockStart
+		data = append(data, block[lo:hi+1]...)
+	}
+
+	return data, nil
+}
+
+// entry returns the object's metadata, revalidating it with HeadObject once the TTL has passed or if revalidate is set.
+func (c *objectCache) entry(ctx context.Context, client *ACSClient, bucket, key string, revalidate bool) (*cacheEntry, error) {
+	metaFile := filepath.Join(c.metaDir(), objectHash(bucket, key)+".json")
+
+	var cached *cacheEntry
+	if data, err := os.ReadFile(metaFile); err == nil {
+		var entry cacheEntry
+		if json.Unmarshal(data, &entry) == nil && entry.Bucket == bucket && entry.Key == key {
+			cached = &entry
+		}
+	}
+	if cached != nil && !revalidate && c.config.TTL > 0 && time.Since(cached.Validated) < c.config.TTL {
+		return cached, nil
+	}
+
+	head, err := client.HeadObject(ctx, bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &cacheEntry{
+		Bucket:    bucket,
+		Key:       key,
+		ETag:      head.ETag,
+		Size:      head.ContentLength,
+		Validated: time.Now(),
+	}
+
+	// Drop blocks belonging to a previous version of the object
+	if cached != nil && cached.ETag != entry.ETag {
+		c.remove(filepath.Join(c.blocksDir(), objectHash(bucket, key), etagHash(cached.ETag)))
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cache entry: %v", err)
+	}
+	if err := writeFileAtomic(metaFile, data); err != nil {
+		return nil, fmt.Errorf("failed to write cache entry: %v", err)
+	}
+
+	return entry, nil
+}
+
+// block returns one block of the object, filling it from the server with the caller's options if it is not cached.
+// It returns an error wrapping errStaleCacheEntry if the object no longer has the entry's ETag.
+func (c *objectCache) block(ctx context.Context, client *ACSClient, entry *cacheEntry, index int64, opts *GetObjectOptions) ([]byte, error) {
+	blockStart := index * c.config.BlockSize
+	blockLen := min(c.config.BlockSize, entry.Size-blockStart)
+	objectDir := objectHash(entry.Bucket, entry.Key)
+	blockFile := filepath.Join(c.blocksDir(), objectDir, etagHash(entry.ETag), strconv.FormatInt(index, 10))
+
+	if data, ok := c.readBlock(blockFile, blockLen); ok {
+		return data, nil
+	}
+
+	data, filled, err := c.fill(ctx, client, entry, blockFile, blockStart, blockLen, opts)
+	if err != nil {
+		return nil, err
+	}
+	if filled {
+		// Account for the block only after releasing the object's lock, since eviction takes it too
+		c.added(blockLen)
+	}
+	return data, nil
+}
+
+// fill downloads a block into blockFile under the object's lock, so other processes wait instead of
+// downloading twice. The download uses the caller's options, such as WithProgress, narrowed to the block.
+// It reports whether the block was downloaded rather than found filled by another process.
+func (c *objectCache) fill(ctx context.Context, client *ACSClient, entry *cacheEntry, blockFile string, blockStart, blockLen int64, opts *GetObjectOptions) ([]byte, bool, error) {
+	lock, err := lockFile(c.lockPath(objectHash(entry.Bucket, entry.Key)))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to lock cache entry: %v", err)
+	}
+	defer unlockFile(lock)
+
+	if data, ok := c.readBlock(blockFile, blockLen); ok {
+		return data, false, nil
+	}
+
+	fillOpts := *opts
+	fillOpts.rangeSpec = fmt.Sprintf("bytes=%d-%d", blockStart, blockStart+blockLen-1)
+	fillOpts.ifMatch = entry.ETag
+	data, err := client.getObject(ctx, entry.Bucket, entry.Key, &fillOpts)
+	if status.Code(err) == codes.FailedPrecondition {
+		return nil, false, fmt.Errorf("%w: %w", errStaleCacheEntry, err)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if int64(len(data)) != blockLen {
+		return nil, false, fmt.Errorf("cache fill returned %d bytes, expected %d", len(data), blockLen)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(blockFile), 0700); err != nil {
+		return nil, false, fmt.Errorf("failed to create cache directory: %v", err)
+	}
+	if err := writeFileAtomic(blockFile, data); err != nil {
+		return nil, false, fmt.Errorf("failed to write cache block: %v", err)
+	}
+
+	return data, true, nil
+}
+
+// readBlock reads a cached block if it is complete and marks it as recently used.
+func (c *objectCache) readBlock(path string, expected int64) ([]byte, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil || int64(len(data)) != expected {
+		return nil, false
+	}
+
+	now := time.Now()
+	os.Chtimes(path, now, now)
+	return data, true
+}
+
+// added records newly cached bytes and evicts if the cache has grown past its cap.
+func (c *objectCache) added(n int64) {
+	c.mu.Lock()
+	c.size += n
+	over := c.size > c.config.MaxSize
+	c.mu.Unlock()
+
+	if over {
+		if err := c.evict(); err != nil {
+			fmt.Printf("Warning: Cache eviction failed: %v\n", err)
+		}
+	}
+}
+
+// evict removes the least recently used blocks until the cache is below its target size.
+// The size is recomputed from disk since other processes share the directory.
+func (c *objectCache) evict() error {
+	lock, err := lockFile(filepath.Join(c.config.Dir, "evict.lock"))
+	if err != nil {
+		return err
+	}
+	defer unlockFile(lock)
+
+	files, err := c.blockFiles()
+	if err != nil {
+		return err
+	}
+
+	var total int64
+	for _, file := range files {
+		total += file.size
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.Before(files[j].modTime)
+	})
+
+	target := int64(float64(c.config.MaxSize) * cacheEvictionTarget)
+	evicted := make(map[string]bool)
+	for _, file := range files {
+		if total <= target {
+			break
+		}
+		if err := os.Remove(file.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		total -= file.size
+		if rel, err := filepath.Rel(c.blocksDir(), file.path); err == nil {
+			evicted[strings.Split(rel, string(filepath.Separator))[0]] = true
+		}
+	}
+
+	c.mu.Lock()
+	c.size = total
+	c.mu.Unlock()
+
+	for objectDir := range evicted {
+		c.forget(objectDir)
+	}
+
+	return nil
+}
+
+// forget removes the metadata and lock file of an object once none of its blocks are cached,
+// so that evicted objects leave nothing behind. It holds the object's lock, and only removes empty
+// directories, so blocks filled concurrently are kept. A process that opened the lock file before it
+// was removed may fill a block alongside one using the new lock file, which only duplicates the download.
+func (c *objectCache) forget(objectDir string) {
+	lockPath := c.lockPath(objectDir)
+	lock, err := lockFile(lockPath)
+	if err != nil {
+		return
+	}
+	defer unlockFile(lock)
+
+	dir := filepath.Join(c.blocksDir(), objectDir)
+	etags, _ := os.ReadDir(dir)
+	for _, etag := range etags {
+		os.Remove(filepath.Join(dir, etag.Name())) // Fails while the version still has blocks
+	}
+	if err := os.Remove(dir); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return
+	}
+
+	os.Remove(filepath.Join(c.metaDir(), objectDir+".json"))
+	os.Remove(lockPath)
+}
+
+// remove deletes a directory of cached blocks and accounts for the freed space.
+func (c *objectCache) remove(dir string) {
+	var freed int64
+	filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			if info, err := d.Info(); err == nil {
+				freed += info.Size()
+			}
+		}
+		return nil
+	})
+	if err := os.RemoveAll(dir); err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	c.size -= freed
+	c.mu.Unlock()
+}
+
+// cachedFile describes a block file on disk.
+type cachedFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+// blockFiles lists every cached block.
+func (c *objectCache) blockFiles() ([]cachedFile, error) {
+	var files []cachedFile
+	err := filepath.WalkDir(c.blocksDir(), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Blocks may be removed by another process while walking
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || strings.HasPrefix(d.Name(), ".") {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		files = append(files, cachedFile{path: path, size: info.Size(), modTime: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan cache directory: %v", err)
+	}
+	return files, nil
+}
+
+func (c *objectCache) blocksDir() string { return filepath.Join(c.config.Dir, "blocks") }
+func (c *objectCache) metaDir() string   { return filepath.Join(c.config.Dir, "meta") }
+func (c *objectCache) locksDir() string  { return filepath.Join(c.config.Dir, "locks") }
+
+// lockPath returns the lock file serializing fills and eviction of an object.
+func (c *objectCache) lockPath(objectDir string) string {
+	return filepath.Join(c.locksDir(), objectDir+".lock")
+}
+
+// objectHash returns a file name safe identifier for a bucket and key.
+func objectHash(bucket, key string) string {
+	sum := sha256.Sum256([]byte(bucket + "\x00" + key))
+	return hex.EncodeToString(sum[:])
+}
+
+// etagHash returns a file name safe identifier for an ETag.
+func etagHash(etag string) string {
+	sum := sha256.Sum256([]byte(etag))
+	return hex.EncodeToString(sum[:8])
+}
+
+// writeFileAtomic writes data to a temporary file in the same directory and renames it into place,
+// so readers in other processes never observe a partially written file.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-"+filepath.Base(path)+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// parseRangeSpec resolves a GetObject range against an object of the given size.
+// An empty spec selects the whole object.
+func parseRangeSpec(spec string, size int64) (int64, int64, error) {
+	if spec == "" {
+		return 0, size - 1, nil
+	}
+	return byterange.Parse(spec, size)
+}
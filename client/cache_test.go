@@ -0,0 +1,251 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testBlockSize = 4096
+
+// newCachedTestClient returns a client over storage with an on-disk cache in a temporary directory.
+func newCachedTestClient(t *testing.T, storage *fakeStorage, config CacheConfig) *ACSClient {
+	t.Helper()
+	config.Dir = t.TempDir()
+	if config.BlockSize == 0 {
+		config.BlockSize = testBlockSize
+	}
+	cache, err := newObjectCache(config)
+	if err != nil {
+		t.Fatalf("newObjectCache() error = %v", err)
+	}
+	client := newTestClient(t, storage)
+	client.cache = cache
+	return client
+}
+
+// testData returns n bytes of a pattern that differs for each seed.
+func testData(n int, seed byte) []byte {
+	data := make([]byte, n)
+	for i := range data {
+		data[i] = byte(i/7) + seed
+	}
+	return data
+}
+
+func TestCacheServesRepeatedReadsFromDisk(t *testing.T) {
+	storage := newFakeStorage()
+	object := testData(3*testBlockSize+100, 1)
+	storage.put("object", object)
+	client := newCachedTestClient(t, storage, CacheConfig{MaxSize: 1 << 20})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		data, err := client.GetObject(ctx, "bucket", "object")
+		if err != nil {
+			t.Fatalf("GetObject() error = %v", err)
+		}
+		if !bytes.Equal(data, object) {
+			t.Fatalf("GetObject() returned %d bytes that differ from the object", len(data))
+		}
+	}
+	if got := storage.called("GetObject"); got != 4 {
+		t.Errorf("GetObject calls = %d, want 4 (one per block)", got)
+	}
+
+	// Ranges spanning blocks are assembled from the cached blocks
+	data, err := client.GetObject(ctx, "bucket", "object", WithRange(fmt.Sprintf("bytes=%d-%d", testBlockSize-10, 2*testBlockSize+9)))
+	if err != nil {
+		t.Fatalf("GetObject() with range error = %v", err)
+	}
+	if want := object[testBlockSize-10 : 2*testBlockSize+10]; !bytes.Equal(data, want) {
+		t.Errorf("GetObject() with range returned %d bytes, want %d matching bytes", len(data), len(want))
+	}
+	if got := storage.called("GetObject"); got != 4 {
+		t.Errorf("GetObject calls after ranged read = %d, want 4", got)
+	}
+}
+
+func TestCacheFillsKeepCallerOptions(t *testing.T) {
+	storage := newFakeStorage()
+	object := testData(3*testBlockSize+100, 1)
+	storage.put("object", object)
+	client := newCachedTestClient(t, storage, CacheConfig{MaxSize: 1 << 20})
+
+	// Every block downloaded for the read reports its progress
+	var mu sync.Mutex
+	var done int
+	var transferred int64
+	progress := WithProgress(func(p Progress) {
+		mu.Lock()
+		defer mu.Unlock()
+		if p.Done {
+			done++
+			transferred += p.BytesTransferred
+		}
+	})
+	if _, err := client.GetObject(context.Background(), "bucket", "object", progress); err != nil {
+		t.Fatalf("GetObject() error = %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if done != 4 || transferred != int64(len(object)) {
+		t.Errorf("progress reported %d finished fills of %d bytes, want 4 of %d", done, transferred, len(object))
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("object", testData(100, 1))
+	client := newCachedTestClient(t, storage, CacheConfig{MaxSize: 1 << 20, TTL: 50 * time.Millisecond})
+	ctx := context.Background()
+
+	if _, err := client.GetObject(ctx, "bucket", "object"); err != nil {
+		t.Fatalf("GetObject() error = %v", err)
+	}
+	if _, err := client.GetObject(ctx, "bucket", "object"); err != nil {
+		t.Fatalf("GetObject() error = %v", err)
+	}
+	if got := storage.called("HeadObject"); got != 1 {
+		t.Errorf("HeadObject calls within TTL = %d, want 1", got)
+	}
+
+	// Once the TTL has passed, the object is revalidated and a new version is read
+	updated := testData(200, 2)
+	storage.put("object", updated)
+	time.Sleep(60 * time.Millisecond)
+	data, err := client.GetObject(ctx, "bucket", "object")
+	if err != nil {
+		t.Fatalf("GetObject() error = %v", err)
+	}
+	if !bytes.Equal(data, updated) {
+		t.Errorf("GetObject() after TTL returned %d bytes, want the updated %d bytes", len(data), len(updated))
+	}
+	if got := storage.called("HeadObject"); got != 2 {
+		t.Errorf("HeadObject calls after TTL = %d, want 2", got)
+	}
+}
+
+func TestCacheNeverMixesVersions(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("object", testData(2*testBlockSize, 1))
+	client := newCachedTestClient(t, storage, CacheConfig{MaxSize: 1 << 20, TTL: time.Hour})
+	ctx := context.Background()
+
+	// Cache only the first block, then replace the object while its metadata is still trusted
+	if _, err := client.GetObject(ctx, "bucket", "object", WithRange("bytes=0-9")); err != nil {
+		t.Fatalf("GetObject() error = %v", err)
+	}
+	updated := testData(2*testBlockSize, 2)
+	storage.put("object", updated)
+
+	// Filling the second block detects the change, so the whole read comes from the new version
+	data, err := client.GetObject(ctx, "bucket", "object")
+	if err != nil {
+		t.Fatalf("GetObject() error = %v", err)
+	}
+	if !bytes.Equal(data, updated) {
+		t.Errorf("GetObject() returned data that is not the updated object")
+	}
+}
+
+func TestCacheConcurrentFill(t *testing.T) {
+	storage := newFakeStorage()
+	object := testData(4*testBlockSize, 1)
+	storage.put("object", object)
+	client := newCachedTestClient(t, storage, CacheConfig{MaxSize: 1 << 20, TTL: time.Hour})
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data, err := client.GetObject(ctx, "bucket", "object")
+			if err == nil && !bytes.Equal(data, object) {
+				t.Errorf("concurrent GetObject() returned data that differs from the object")
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("concurrent GetObject() error = %v", err)
+		}
+	}
+
+	// The object's lock lets only one reader download each block
+	if got := storage.called("GetObject"); got != 4 {
+		t.Errorf("GetObject calls = %d, want 4 (one per block)", got)
+	}
+}
+
+func TestCacheEviction(t *testing.T) {
+	storage := newFakeStorage()
+	keys := []string{"a", "b", "c", "d"}
+	for i, key := range keys {
+		storage.put(key, testData(testBlockSize, byte(i)))
+	}
+	client := newCachedTestClient(t, storage, CacheConfig{MaxSize: 2 * testBlockSize, TTL: time.Hour})
+	ctx := context.Background()
+
+	for _, key := range keys {
+		if _, err := client.GetObject(ctx, "bucket", key); err != nil {
+			t.Fatalf("GetObject(%q) error = %v", key, err)
+		}
+		// Distinct modification times make the least recently used order deterministic
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cache := client.cache
+	files, err := cache.blockFiles()
+	if err != nil {
+		t.Fatalf("blockFiles() error = %v", err)
+	}
+	var total int64
+	for _, file := range files {
+		total += file.size
+	}
+	if total > cache.config.MaxSize {
+		t.Errorf("cache holds %d bytes, more than its maximum of %d", total, cache.config.MaxSize)
+	}
+	if cache.size != total {
+		t.Errorf("cache accounts for %d bytes, disk holds %d", cache.size, total)
+	}
+
+	// Evicted objects leave no metadata or lock files behind, while cached ones are served from disk
+	locks, err := os.ReadDir(cache.locksDir())
+	if err != nil {
+		t.Fatalf("reading locks directory: %v", err)
+	}
+	if len(locks) != len(files) {
+		t.Errorf("locks directory holds %d files for %d cached objects", len(locks), len(files))
+	}
+	for _, key := range keys[:2] {
+		if _, err := os.Stat(cache.lockPath(objectHash("bucket", key))); !os.IsNotExist(err) {
+			t.Errorf("lock file of evicted object %q still exists", key)
+		}
+		metaFile := filepath.Join(cache.metaDir(), objectHash("bucket", key)+".json")
+		if _, err := os.Stat(metaFile); !os.IsNotExist(err) {
+			t.Errorf("metadata of evicted object %q still exists", key)
+		}
+	}
+
+	calls := storage.called("GetObject")
+	if _, err := client.GetObject(ctx, "bucket", "d"); err != nil {
+		t.Fatalf("GetObject() error = %v", err)
+	}
+	if got := storage.called("GetObject"); got != calls {
+		t.Errorf("most recently used object was downloaded again")
+	}
+}
@@ -8,26 +8,27 @@ import (
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
+	"time"
 
 	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
 	"github.com/pierrec/lz4/v4"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gopkg.in/yaml.v2"
 )
 
 // CreateBucket sends a request to create a new bucket.
 // It requires a bucket name and region specification and returns an error if bucket creation fails.
 func (client *ACSClient) CreateBucket(ctx context.Context, bucket string) error {
-	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+	return withRetryNoReturn(ctx, client.retry.singleAttempt(), func(ctx context.Context) error {
 		req := &pb.CreateBucketRequest{
 			Bucket: bucket,
 		}
 
 		_, err := client.client.CreateBucket(ctx, req)
 		if err != nil {
-			return fmt.Errorf("failed to create bucket: %v", err)
+			return fmt.Errorf("failed to create bucket: %w", err)
 		}
 
 		return nil
@@ -37,14 +38,14 @@ func (client *ACSClient) CreateBucket(ctx context.Context, bucket string) error
 // DeleteBucket requests deletion of the specified bucket.
 // It returns an error if bucket deletion fails or if the bucket doesn't exist.
 func (client *ACSClient) DeleteBucket(ctx context.Context, bucket string) error {
-	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+	return withRetryNoReturn(ctx, client.retry.singleAttempt(), func(ctx context.Context) error {
 		req := &pb.DeleteBucketRequest{
 			Bucket: bucket,
 		}
 
 		_, err := client.client.DeleteBucket(ctx, req)
 		if err != nil {
-			return fmt.Errorf("failed to delete bucket: %v", err)
+			return fmt.Errorf("failed to delete bucket: %w", err)
 		}
 
 		return nil
@@ -59,7 +60,7 @@ func (client *ACSClient) ListBuckets(ctx context.Context) ([]*pb.Bucket, error)
 
 		resp, err := client.client.ListBuckets(ctx, req)
 		if err != nil {
-			return nil, fmt.Errorf("failed to list buckets: %v", err)
+			return nil, fmt.Errorf("failed to list buckets: %w", err)
 		}
 
 		return resp.Buckets, nil
@@ -67,56 +68,101 @@ func (client *ACSClient) ListBuckets(ctx context.Context) ([]*pb.Bucket, error)
 }
 
 // PutObject uploads data to the specified bucket and key.
+// Tags may be attached with WithTags and WithProgress reports the upload's progress.
+// WithContentType and WithUserMetadata set the object's content type and user-defined metadata.
+// WithRetention and WithLegalHold protect the object in buckets with object lock enabled.
+// WithServerSideEncryption, WithSSEKMS and WithSSECustomerKey select how the object is encrypted at rest.
 // It automatically compresses large objects when beneficial and returns an error if the upload fails.
-func (client *ACSClient) PutObject(ctx context.Context, bucket, key string, data []byte) error {
-	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
-		isCompressed := false
-		dataLen := len(data)
+func (client *ACSClient) PutObject(ctx context.Context, bucket, key string, data []byte, options ...ObjectOption) error {
+	opts, err := applyObjectOptions("PutObject", optTags|optProgress|optRetention|optLegalHold|optServerSideEncryption|optCustomerKey|optContentType|optUserMetadata, options)
+	if err != nil {
+		return err
+	}
+	if err := validateObjectTags(opts.tags); err != nil {
+		return err
+	}
+	if opts.retention != nil {
+		if err := opts.retention.validate(); err != nil {
+			return err
+		}
+	}
+	if err := opts.encryption.validate(); err != nil {
+		return err
+	}
 
-		if dataLen >= compressionThreshold {
-			// Estimate compression ratio first
-			ratio, err := estimateCompressionRatio(data)
-			if err != nil {
-				// Log error but continue without compression
-				fmt.Printf("Warning: Compression estimation failed: %v\n", err)
-			} else if ratio < minCompressionRatio {
-				var buf bytes.Buffer
-				w := lz4.NewWriter(&buf)
-				w.Apply(lz4.CompressionLevelOption(0))
-				if _, err := w.Write(data); err != nil {
-					return fmt.Errorf("failed to compress data: %v", err)
-				}
-				if err := w.Close(); err != nil {
-					return fmt.Errorf("failed to close lz4 writer: %v", err)
-				}
+	reporter := newProgressReporter(opts.progress)
+	defer reporter.close()
+	rawLen := int64(len(data))
 
-				compressed := buf.Bytes()
-				// Only use compression if it actually reduces size
-				if len(compressed) < dataLen {
-					isCompressed = true
-					data = compressed
-					dataLen = len(compressed)
-				}
+	// Compress once up front so that every attempt sends the same bytes
+	isCompressed := false
+	if len(data) >= compressionThreshold {
+		// Estimate compression ratio first
+		ratio, err := estimateCompressionRatio(data)
+		if err != nil {
+			// Log error but continue without compression
+			fmt.Printf("Warning: Compression estimation failed: %v\n", err)
+		} else if ratio < minCompressionRatio {
+			var buf bytes.Buffer
+			w := lz4.NewWriter(&buf)
+			w.Apply(lz4.CompressionLevelOption(0))
+			if _, err := w.Write(data); err != nil {
+				return fmt.Errorf("failed to compress data: %v", err)
+			}
+			if err := w.Close(); err != nil {
+				return fmt.Errorf("failed to close lz4 writer: %v", err)
+			}
+
+			compressed := buf.Bytes()
+			// Only use compression if it actually reduces size
+			if len(compressed) < len(data) {
+				isCompressed = true
+				data = compressed
 			}
 		}
+	}
+	dataLen := len(data)
 
-		stream, err := client.client.PutObject(ctx)
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		reporter.startAttempt()
+
+		stream, err := client.client.PutObject(withSigningResource(ctx, bucket, key))
 		if err != nil {
-			return fmt.Errorf("failed to start PutObject stream: %v", err)
+			return fmt.Errorf("failed to start PutObject stream: %w", err)
 		}
 
 		// Send parameters
+		params := &pb.PutObjectInput{
+			Bucket:       bucket,
+			Key:          key,
+			IsCompressed: &isCompressed,
+			Tags:         opts.tags,
+			UserMetadata: opts.userMetadata,
+		}
+		if opts.contentType != "" {
+			params.ContentType = &opts.contentType
+		}
+		if opts.retention != nil {
+			params.ObjectLockMode = &opts.retention.Mode
+			params.ObjectLockRetainUntil = timestamppb.New(opts.retention.RetainUntil)
+		}
+		if opts.legalHold {
+			params.ObjectLockLegalHold = &opts.legalHold
+		}
+		if opts.encryption.algorithm != "" {
+			params.ServerSideEncryption = &opts.encryption.algorithm
+		}
+		if opts.encryption.kmsKeyID != "" {
+			params.SseKmsKeyId = &opts.encryption.kmsKeyID
+		}
+		params.SseCustomerKey = sseCustomerKey(opts.encryption.customerKey)
 		err = stream.Send(&pb.PutObjectRequest{
 			Data: &pb.PutObjectRequest_Parameters{
-				Parameters: &pb.PutObjectInput{
-					Bucket:       bucket,
-					Key:          key,
-					IsCompressed: &isCompressed,
-				},
+				Parameters: params,
 			},
 		})
 		if err != nil {
-			return fmt.Errorf("failed to send parameters: %v", err)
+			return fmt.Errorf("failed to send parameters: %w", err)
 		}
 
 		// Determine chunk size based on data size
@@ -134,6 +180,13 @@ func (client *ACSClient) PutObject(ctx context.Context, bucket, key string, data
 			chunkSize = 4 * 1024 * 1024 // 4MB chunks for huge files
 		}
 
+		progress := Progress{
+			Bucket:     bucket,
+			Key:        key,
+			TotalBytes: rawLen,
+			Compressed: isCompressed,
+		}
+
 		// Send data in chunks
 		for i := 0; i < dataLen; i += chunkSize {
 			end := i + chunkSize
@@ -141,164 +194,286 @@ func (client *ACSClient) PutObject(ctx context.Context, bucket, key string, data
 				end = dataLen
 			}
 
+			if err := client.limits.upload.wait(ctx, end-i); err != nil {
+				return err
+			}
 			err := stream.Send(&pb.PutObjectRequest{
 				Data: &pb.PutObjectRequest_Chunk{
 					Chunk: data[i:end],
 				},
 			})
 			if err != nil {
-				return fmt.Errorf("failed to send chunk: %v", err)
+				return fmt.Errorf("failed to send chunk: %w", err)
+			}
+
+			progress.BytesTransferred = int64(end)
+			progress.RawBytes = int64(end)
+			if isCompressed {
+				progress.RawBytes = int64(float64(end) / float64(dataLen) * float64(rawLen))
 			}
+			reporter.report(progress)
 		}
 
 		_, err = stream.CloseAndRecv()
 		if err != nil {
-			return fmt.Errorf("failed to close stream: %v", err)
+			return fmt.Errorf("failed to close stream: %w", err)
 		}
 
+		progress.BytesTransferred = int64(dataLen)
+		progress.RawBytes = rawLen
+		progress.Done = true
+		reporter.report(progress)
+
 		return nil
 	})
 }
 
 // GetObject downloads the specified object from the server.
 // If rangeSpec is provided in the format "bytes=start-end" (e.g., "bytes=0-9" for first 10 bytes),
-// only the specified range of the object will be downloaded.
+// only the specified range of the object will be downloaded. WithProgress reports the download's progress.
+// WithIfMatch makes the download fail with ErrPreconditionFailed if the object's ETag differs.
 // It returns the object's data and an error if the download fails.
 func (client *ACSClient) GetObject(ctx context.Context, bucket, key string, options ...GetObjectOption) ([]byte, error) {
 	// Apply options
-	opts := &GetObjectOptions{
-		rangeSpec: "",
+	opts, err := applyObjectOptions("GetObject", optRange|optVersionID|optProgress|optCustomerKey|optIfMatch, options)
+	if err != nil {
+		return nil, err
 	}
-	for _, option := range options {
-		option(opts)
+	if err := opts.encryption.validate(); err != nil {
+		return nil, err
 	}
 
-	return withRetry(ctx, client.retry, func(ctx context.Context) ([]byte, error) {
-		req := &pb.GetObjectRequest{
-			Bucket: bucket,
-			Key:    key,
-		}
-
-		if opts.rangeSpec != "" {
-			req.Range = &opts.rangeSpec
-		}
+	// Serve from the local cache when one is configured (it only holds the latest version,
+	// and never objects encrypted with a customer-provided key)
+	if client.cache != nil && opts.versionID == "" && opts.ifMatch == "" && opts.encryption.customerKey == nil {
+		return client.cache.get(ctx, client, bucket, key, opts)
+	}
 
-		stream, err := client.client.GetObject(ctx, req)
-		if err != nil {
-			return nil, fmt.Errorf("failed to start GetObject stream: %v", err)
-		}
+	data, err := client.getObject(ctx, bucket, key, opts)
+	if opts.ifMatch != "" && status.Code(err) == codes.FailedPrecondition {
+		return nil, fmt.Errorf("object ETag does not match %s: %w", opts.ifMatch, ErrPreconditionFailed)
+	}
+	return data, err
+}
 
-		// Get first message to check metadata
-		resp, err := stream.Recv()
-		if err != nil {
-			return nil, fmt.Errorf("error receiving metadata: %v", err)
-		}
+// getObject downloads an object (or a range of it) directly from the server.
+func (client *ACSClient) getObject(ctx context.Context, bucket, key string, opts *GetObjectOptions) ([]byte, error) {
+	reporter := newProgressReporter(opts.progress)
+	defer reporter.close()
+	total := int64(-1)
+	if reporter != nil {
+		total = client.transferSize(ctx, bucket, key, opts)
+	}
 
-		metadata := resp.GetMetadata()
-		if metadata == nil {
-			return nil, fmt.Errorf("missing metadata in first message")
-		}
+	return withRetry(ctx, client.retry, func(ctx context.Context) ([]byte, error) {
+		reporter.startAttempt()
+		return hedge(ctx, client.hedger, client.retry.limiter, func(ctx context.Context, firstByte func() bool) ([]byte, error) {
+			req := &pb.GetObjectRequest{
+				Bucket: bucket,
+				Key:    key,
+			}
 
-		isCompressed := metadata.GetIsCompressed()
+			if opts.rangeSpec != "" {
+				req.Range = &opts.rangeSpec
+			}
+			if opts.versionID != "" {
+				req.VersionId = &opts.versionID
+			}
+			if opts.ifMatch != "" {
+				req.IfMatch = &opts.ifMatch
+			}
+			req.SseCustomerKey = sseCustomerKey(opts.encryption.customerKey)
 
-		// Start with a small initial buffer (256KB) and grow as needed
-		buf := bytes.NewBuffer(make([]byte, 0, 256*1024))
+			stream, err := client.client.GetObject(withSigningResource(ctx, bucket, key), req)
+			if err != nil {
+				return nil, fmt.Errorf("failed to start GetObject stream: %w", err)
+			}
 
-		// Read all chunks
-		for {
+			// Get first message to check metadata
 			resp, err := stream.Recv()
-			if err == io.EOF {
-				break
-			}
 			if err != nil {
-				return nil, fmt.Errorf("error receiving chunk: %v", err)
+				return nil, fmt.Errorf("error receiving metadata: %w", err)
 			}
-			if chunk := resp.GetChunk(); chunk != nil {
-				if _, err := buf.Write(chunk); err != nil {
-					return nil, fmt.Errorf("error writing chunk: %v", err)
-				}
-			}
-		}
 
-		data := buf.Bytes()
+			metadata := resp.GetMetadata()
+			if metadata == nil {
+				return nil, fmt.Errorf("missing metadata in first message")
+			}
+			if !firstByte() {
+				return nil, context.Canceled // Another hedged request answered first
+			}
 
-		// Decompress data if it was compressed
-		if isCompressed {
-			// Create a reader for the compressed data
-			r := lz4.NewReader(bytes.NewReader(data))
+			isCompressed := metadata.GetIsCompressed()
+			progress := Progress{
+				Bucket:     bucket,
+				Key:        key,
+				TotalBytes: total,
+				Compressed: isCompressed,
+			}
 
-			// Pre-allocate decompression buffer - LZ4 typically has 2x compression ratio
-			decompressed := make([]byte, 0, len(data)*2)
+			// Start with a small initial buffer (256KB) and grow as needed
+			buf := bytes.NewBuffer(make([]byte, 0, 256*1024))
 
-			// Read the decompressed data in chunks to avoid large allocations
-			chunk := make([]byte, 32*1024*1024) // 32MB chunks
+			// Read all chunks
 			for {
-				n, err := r.Read(chunk)
+				resp, err := stream.Recv()
 				if err == io.EOF {
 					break
 				}
 				if err != nil {
-					return nil, fmt.Errorf("failed to decompress data: %v", err)
+					return nil, fmt.Errorf("error receiving chunk: %w", err)
+				}
+				if chunk := resp.GetChunk(); chunk != nil {
+					if err := client.limits.download.wait(ctx, len(chunk)); err != nil {
+						return nil, err
+					}
+					if _, err := buf.Write(chunk); err != nil {
+						return nil, fmt.Errorf("error writing chunk: %v", err)
+					}
+
+					progress.BytesTransferred += int64(len(chunk))
+					if !isCompressed {
+						progress.RawBytes = progress.BytesTransferred
+					}
+					reporter.report(progress)
 				}
-				decompressed = append(decompressed, chunk[:n]...)
 			}
-			data = decompressed
-		}
 
-		return data, nil
+			data := buf.Bytes()
+
+			// Decompress data if it was compressed
+			if isCompressed {
+				if data, err = decompressData(data); err != nil {
+					return nil, err
+				}
+			}
+
+			progress.RawBytes = int64(len(data))
+			progress.Done = true
+			reporter.report(progress)
+
+			return data, nil
+		})
 	})
 }
 
+// transferSize returns the number of bytes a GetObject with opts will return, or -1 if it cannot be determined.
+func (client *ACSClient) transferSize(ctx context.Context, bucket, key string, opts *GetObjectOptions) int64 {
+	var options []ObjectOption
+	if opts.versionID != "" {
+		options = append(options, WithVersionID(opts.versionID))
+	}
+	if opts.encryption.customerKey != nil {
+		options = append(options, WithSSECustomerKey(opts.encryption.customerKey))
+	}
+	head, err := client.HeadObject(ctx, bucket, key, options...)
+	if err != nil {
+		return -1
+	}
+
+	start, end, err := parseRangeSpec(opts.rangeSpec, head.ContentLength)
+	if err != nil {
+		return -1
+	}
+	return end - start + 1
+}
+
 // DeleteObject removes a single object from a bucket.
-// It returns an error if deletion fails.
-func (client *ACSClient) DeleteObject(ctx context.Context, bucket, key string) error {
-	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+// In a versioned bucket it creates a delete marker, unless WithVersionID selects a version to remove permanently.
+// Removing a version under GOVERNANCE retention requires WithBypassGovernanceRetention.
+// It returns an error wrapping ErrObjectLocked if retention or a legal hold blocks the deletion.
+func (client *ACSClient) DeleteObject(ctx context.Context, bucket, key string, options ...ObjectOption) error {
+	opts, err := applyObjectOptions("DeleteObject", optVersionID|optBypassGovernance, options)
+	if err != nil {
+		return err
+	}
+
+	return withRetryNoReturn(ctx, client.retry.singleAttempt(), func(ctx context.Context) error {
 		req := &pb.DeleteObjectRequest{
 			Bucket: bucket,
 			Key:    key,
 		}
+		if opts.versionID != "" {
+			req.VersionId = &opts.versionID
+		}
+		if opts.bypassGovernance {
+			req.BypassGovernanceRetention = &opts.bypassGovernance
+		}
 
 		_, err := client.client.DeleteObject(ctx, req)
 		if err != nil {
-			return fmt.Errorf("failed to delete object: %v", err)
+			return fmt.Errorf("failed to delete object: %w", objectLockedError(err))
 		}
 
 		return nil
 	})
 }
 
-// HeadObject retrieves metadata for a specific object.
+// HeadObject retrieves metadata for a specific object, or for a specific version with WithVersionID.
+// Objects encrypted with a customer-provided key require WithSSECustomerKey.
 // It returns the object's metadata and an error if the operation fails.
-func (client *ACSClient) HeadObject(ctx context.Context, bucket, key string) (*HeadObjectOutput, error) {
+func (client *ACSClient) HeadObject(ctx context.Context, bucket, key string, options ...ObjectOption) (*HeadObjectOutput, error) {
+	opts, err := applyObjectOptions("HeadObject", optVersionID|optCustomerKey, options)
+	if err != nil {
+		return nil, err
+	}
+
 	return withRetry(ctx, client.retry, func(ctx context.Context) (*HeadObjectOutput, error) {
 		req := &pb.HeadObjectRequest{
 			Bucket: bucket,
 			Key:    key,
 		}
+		if opts.versionID != "" {
+			req.VersionId = &opts.versionID
+		}
+		req.SseCustomerKey = sseCustomerKey(opts.encryption.customerKey)
 
-		resp, err := client.client.HeadObject(ctx, req)
+		resp, err := hedge(ctx, client.hedger, client.retry.limiter, func(ctx context.Context, firstByte func() bool) (*pb.HeadObjectResponse, error) {
+			resp, err := client.client.HeadObject(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			if !firstByte() {
+				return nil, context.Canceled // Another hedged request answered first
+			}
+			return resp, nil
+		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to head object: %v", err)
-		}
-
-		return &HeadObjectOutput{
-			ContentType:          resp.Metadata.ContentType,
-			ContentLength:        resp.Metadata.Size,
-			LastModified:         resp.Metadata.LastModified.AsTime(),
-			ETag:                 resp.Metadata.Etag,
-			ContentEncoding:      resp.Metadata.ContentEncoding,
-			ContentLanguage:      resp.Metadata.ContentLanguage,
-			VersionId:            resp.Metadata.VersionId,
-			ServerSideEncryption: resp.Metadata.ServerSideEncryption,
-			UserMetadata:         resp.Metadata.UserMetadata,
-		}, nil
+			return nil, fmt.Errorf("failed to head object: %w", err)
+		}
+
+		return headObjectOutput(resp.Metadata), nil
 	})
 }
 
+// headObjectOutput converts object metadata to a HeadObjectOutput.
+func headObjectOutput(metadata *pb.ObjectMetadata) *HeadObjectOutput {
+	output := &HeadObjectOutput{
+		ContentType:          metadata.ContentType,
+		ContentLength:        metadata.Size,
+		LastModified:         metadata.LastModified.AsTime(),
+		ETag:                 metadata.Etag,
+		ContentEncoding:      metadata.ContentEncoding,
+		ContentLanguage:      metadata.ContentLanguage,
+		VersionId:            metadata.VersionId,
+		ServerSideEncryption: metadata.ServerSideEncryption,
+		SSEKMSKeyID:          metadata.SseKmsKeyId,
+		SSECustomerKeyMD5:    metadata.SseCustomerKeyMd5,
+		UserMetadata:         metadata.UserMetadata,
+		TagCount:             metadata.TagCount,
+		ObjectLockMode:       metadata.ObjectLockMode,
+		ObjectLockLegalHold:  metadata.ObjectLockLegalHold,
+	}
+	if metadata.ObjectLockRetainUntil != nil {
+		output.ObjectLockRetainUntil = metadata.ObjectLockRetainUntil.AsTime()
+	}
+	return output
+}
+
 // DeleteObjects requests bulk deletion of objects in a bucket.
 // It returns an error if any object deletion fails.
 func (client *ACSClient) DeleteObjects(ctx context.Context, bucket string, keys []string) error {
-	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+	return withRetryNoReturn(ctx, client.retry.singleAttempt(), func(ctx context.Context) error {
 		objects := make([]*pb.ObjectIdentifier, len(keys))
 		for i, key := range keys {
 			objects[i] = &pb.ObjectIdentifier{Key: key}
@@ -311,7 +486,7 @@ func (client *ACSClient) DeleteObjects(ctx context.Context, bucket string, keys
 
 		resp, err := client.client.DeleteObjects(ctx, req)
 		if err != nil {
-			return fmt.Errorf("failed to delete objects: %v", err)
+			return fmt.Errorf("failed to delete objects: %w", err)
 		}
 
 		// Check for partial failures
@@ -326,44 +501,113 @@ func (client *ACSClient) DeleteObjects(ctx context.Context, bucket string, keys
 // ListObjects retrieves object keys from the server based on given options.
 // It returns a list of object keys and an error if the operation fails.
 func (client *ACSClient) ListObjects(ctx context.Context, bucket string, opts *ListObjectsOptions) ([]string, error) {
-	return withRetry(ctx, client.retry, func(ctx context.Context) ([]string, error) {
-		req := &pb.ListObjectsRequest{
-			Bucket: bucket,
+	objects, err := client.ListObjectSummaries(ctx, bucket, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		keys = append(keys, obj.Key)
+	}
+
+	return keys, nil
+}
+
+// ListObjectSummaries retrieves object summaries (key, size, modification time and ETag) based on given options.
+// Use it instead of ListObjects when more than the key is needed, to avoid a HeadObject per key.
+// It returns a list of object summaries and an error if the operation fails.
+func (client *ACSClient) ListObjectSummaries(ctx context.Context, bucket string, opts *ListObjectsOptions) ([]*pb.ObjectSummary, error) {
+	var objects []*pb.ObjectSummary
+	err := client.listObjectSummaries(ctx, bucket, opts, func(obj *pb.ObjectSummary) bool {
+		objects = append(objects, obj)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// listPageSize is the number of objects listObjectSummaries requests at a time.
+const listPageSize = 1000
+
+// listObjectSummaries passes each object summary to yield, stopping early if yield returns false.
+// Objects are requested a page at a time and yield only runs between requests, so a slow caller holds neither
+// a concurrency slot nor a circuit breaker probe while it handles them. A retried page resumes after the last
+// object it received.
+func (client *ACSClient) listObjectSummaries(ctx context.Context, bucket string, opts *ListObjectsOptions, yield func(*pb.ObjectSummary) bool) error {
+	var options ListObjectsOptions
+	if opts != nil {
+		options = *opts
+	}
+	startAfter := options.StartAfter
+	var received int32
+
+	for {
+		pageSize := int32(listPageSize)
+		if options.MaxKeys > 0 {
+			if received >= options.MaxKeys {
+				return nil
+			}
+			pageSize = min(pageSize, options.MaxKeys-received)
 		}
-		if opts != nil {
-			if opts.Prefix != "" {
-				req.Prefix = &opts.Prefix
+
+		var page []*pb.ObjectSummary
+		err := withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+			remaining := pageSize - int32(len(page))
+			if remaining == 0 {
+				return nil
 			}
-			if opts.StartAfter != "" {
-				req.StartAfter = &opts.StartAfter
+			req := &pb.ListObjectsRequest{
+				Bucket:  bucket,
+				MaxKeys: &remaining,
 			}
-			if opts.MaxKeys > 0 {
-				req.MaxKeys = &opts.MaxKeys
+			if options.Prefix != "" {
+				req.Prefix = &options.Prefix
 			}
-		}
-
-		stream, err := client.client.ListObjects(ctx, req)
-		if err != nil {
-			return nil, fmt.Errorf("failed to list objects: %v", err)
-		}
-
-		var keys []string
-		for {
-			resp, err := stream.Recv()
-			if err == io.EOF {
-				break
+			after := startAfter
+			if len(page) > 0 {
+				after = page[len(page)-1].Key
+			}
+			if after != "" {
+				req.StartAfter = &after
 			}
+
+			stream, err := client.client.ListObjects(withSigningResource(ctx, bucket, ""), req)
 			if err != nil {
-				return nil, err
+				return fmt.Errorf("failed to list objects: %w", err)
 			}
 
-			if obj := resp.GetObject(); obj != nil {
-				keys = append(keys, obj.Key)
+			for {
+				resp, err := stream.Recv()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+
+				if obj := resp.GetObject(); obj != nil {
+					page = append(page, obj)
+				}
 			}
+		})
+		if err != nil {
+			return err
 		}
 
-		return keys, nil
-	})
+		for _, obj := range page {
+			received++
+			if !yield(obj) {
+				return nil
+			}
+		}
+		if int32(len(page)) < pageSize {
+			return nil
+		}
+		startAfter = page[len(page)-1].Key
+	}
 }
 
 // HeadBucket retrieves metadata for a specific bucket.
@@ -376,7 +620,7 @@ func (client *ACSClient) HeadBucket(ctx context.Context, bucket string) (*HeadBu
 
 		resp, err := client.client.HeadBucket(ctx, req)
 		if err != nil {
-			return nil, fmt.Errorf("failed to head bucket: %v", err)
+			return nil, fmt.Errorf("failed to head bucket: %w", err)
 		}
 
 		return &HeadBucketOutput{
@@ -387,17 +631,24 @@ func (client *ACSClient) HeadBucket(ctx context.Context, bucket string) (*HeadBu
 
 // RotateKey checks whether key rotation is needed and performs it if necessary.
 // The force parameter may be used to force rotation regardless of timing.
+// The credentials file is rewritten under an exclusive lock and replaced atomically, changing only the
+// current profile's secret. The previous file is kept as credentials.yaml.bak until the new secret
+// authenticates successfully.
 // It returns an error if the rotation fails.
 func (client *ACSClient) RotateKey(ctx context.Context, force bool) error {
-	// Get the home directory
-	homeDir, err := os.UserHomeDir()
+	credsFile, err := credentialsFilePath()
 	if err != nil {
-		return fmt.Errorf("failed to get home directory: %v", err)
+		return err
 	}
 
-	credsFile := filepath.Join(homeDir, ".acs", "credentials.yaml")
+	// Serialize rotations across processes sharing the credentials file
+	lock, err := lockFile(credsFile + ".lock")
+	if err != nil {
+		return fmt.Errorf("failed to lock credentials file: %v", err)
+	}
+	defer unlockFile(lock)
 
-	// Read the entire credentials file
+	// Read the credentials file under the lock so a concurrent rotation is observed
 	data, err := os.ReadFile(credsFile)
 	if err != nil {
 		return fmt.Errorf("failed to read credentials file: %v", err)
@@ -408,42 +659,58 @@ func (client *ACSClient) RotateKey(ctx context.Context, force bool) error {
 		return fmt.Errorf("failed to unmarshal credentials: %v", err)
 	}
 
-	// Get current profile name from environment variable
-	profile := os.Getenv("ACS_PROFILE")
-	if profile == "" {
-		profile = "default"
-	}
-
+	profile := currentProfile()
 	creds, ok := profiles[profile]
 	if !ok {
 		return fmt.Errorf("profile '%s' not found in credentials file", profile)
 	}
 
+	// Make sure the secret can be updated in place before the service replaces it
+	if _, err := updateProfileSecret(data, profile, creds.SecretAccessKey); err != nil {
+		return fmt.Errorf("failed to update credentials: %v", err)
+	}
+
 	resp, err := client.client.RotateKey(ctx, &pb.RotateKeyRequest{
 		AccessKeyId: creds.AccessKeyID,
 		Force:       &force,
 	})
 	if err != nil {
-		return fmt.Errorf("key rotation failed: %v", err)
+		return fmt.Errorf("key rotation failed: %w", err)
 	}
 	if !resp.Rotated {
 		return nil
 	}
 
-	// Update only the current profile's credentials
-	updatedCreds := creds
-	updatedCreds.SecretAccessKey = resp.NewSecretAccessKey
-	profiles[profile] = updatedCreds
+	// Update only the current profile's secret, keeping the rest of the file intact
+	updated, err := updateProfileSecret(data, profile, resp.NewSecretAccessKey)
+	if err != nil {
+		return fmt.Errorf("failed to update credentials: %v", err)
+	}
+
+	// Keep the previous secret until the new one is confirmed
+	backupFile := credsFile + ".bak"
+	if err := writeFileSynced(backupFile, data, 0600); err != nil {
+		return fmt.Errorf("failed to back up credentials file: %v", err)
+	}
+
+	if err := writeFileSynced(credsFile, updated, 0600); err != nil {
+		return fmt.Errorf("failed to update credentials file (previous credentials kept in %s): %v", backupFile, err)
+	}
 
-	// Marshal updated profiles
-	data, err = yaml.Marshal(profiles)
+	// Confirm the new secret before discarding the backup, without racing a concurrent re-authentication
+	creds.SecretAccessKey = resp.NewSecretAccessKey
+	client.authMu.Lock()
+	err = client.authenticate(ctx, &creds)
+	if err == nil {
+		client.authenticatedAt = time.Now()
+	}
+	client.authMu.Unlock()
 	if err != nil {
-		return fmt.Errorf("failed to marshal credentials: %v", err)
+		return fmt.Errorf("rotated key could not be confirmed (previous credentials kept in %s): %w", backupFile, err)
 	}
 
-	// Write back to file
-	if err := os.WriteFile(credsFile, data, 0600); err != nil {
-		return fmt.Errorf("failed to update credentials file: %v", err)
+	if err := os.Remove(backupFile); err != nil {
+		fmt.Printf("Warning: Failed to remove credentials backup: %v\n", err)
 	}
 
 	return nil
@@ -464,14 +731,14 @@ func (client *ACSClient) ShareBucket(ctx context.Context, bucket string) error {
 			if ok {
 				switch st.Code() {
 				case codes.NotFound:
-					return fmt.Errorf("bucket does not exist: %v", err)
+					return fmt.Errorf("bucket does not exist: %w", err)
 				case codes.PermissionDenied:
-					return fmt.Errorf("service lacks permission to access bucket: %v", err)
+					return fmt.Errorf("service lacks permission to access bucket: %w", err)
 				case codes.InvalidArgument:
-					return fmt.Errorf("invalid bucket name: %v", err)
+					return fmt.Errorf("invalid bucket name: %w", err)
 				}
 			}
-			return fmt.Errorf("failed to share bucket: %v", err)
+			return fmt.Errorf("failed to share bucket: %w", err)
 		}
 
 		return nil
@@ -479,20 +746,144 @@ func (client *ACSClient) ShareBucket(ctx context.Context, bucket string) error {
 }
 
 // CopyObject copies an object from a source bucket/key to a destination bucket/key.
+// WithVersionID selects the version of the source to copy, and WithCopySourceSSECustomerKey decrypts a source
+// encrypted with a customer-provided key. The encryption options select how the copy is encrypted.
 // It returns an error if the copy operation fails.
-func (client *ACSClient) CopyObject(ctx context.Context, bucket, copySource, key string) error {
-	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+func (client *ACSClient) CopyObject(ctx context.Context, bucket, copySource, key string, options ...ObjectOption) error {
+	opts, err := applyObjectOptions("CopyObject", copyObjectOptions, options)
+	if err != nil {
+		return err
+	}
+	if err := opts.encryption.validate(); err != nil {
+		return err
+	}
+
+	return withRetryNoReturn(ctx, client.retry.singleAttempt(), func(ctx context.Context) error {
 		req := &pb.CopyObjectRequest{
 			Bucket:     bucket,
 			CopySource: copySource,
 			Key:        key,
 		}
+		if opts.versionID != "" {
+			req.CopySourceVersionId = &opts.versionID
+		}
+		if opts.encryption.algorithm != "" {
+			req.ServerSideEncryption = &opts.encryption.algorithm
+		}
+		if opts.encryption.kmsKeyID != "" {
+			req.SseKmsKeyId = &opts.encryption.kmsKeyID
+		}
+		req.SseCustomerKey = sseCustomerKey(opts.encryption.customerKey)
+		req.CopySourceSseCustomerKey = sseCustomerKey(opts.encryption.sourceKey)
 
 		_, err := client.client.CopyObject(ctx, req)
 		if err != nil {
-			return fmt.Errorf("failed to copy object: %v", err)
+			return fmt.Errorf("failed to copy object: %w", err)
 		}
 
 		return nil
 	})
 }
+
+// PutBucketVersioning sets the versioning state of a bucket.
+// The status must be VersioningEnabled or VersioningSuspended; versioning cannot be disabled once enabled.
+// It returns an error if the operation fails.
+func (client *ACSClient) PutBucketVersioning(ctx context.Context, bucket, versioningStatus string) error {
+	if versioningStatus != VersioningEnabled && versioningStatus != VersioningSuspended {
+		return fmt.Errorf("invalid versioning status %q: must be %q or %q", versioningStatus, VersioningEnabled, VersioningSuspended)
+	}
+
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		req := &pb.PutBucketVersioningRequest{
+			Bucket: bucket,
+			Status: versioningStatus,
+		}
+
+		_, err := client.client.PutBucketVersioning(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to put bucket versioning: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetBucketVersioning retrieves the versioning state of a bucket.
+// It returns VersioningEnabled, VersioningSuspended, or an empty string if versioning was never enabled.
+func (client *ACSClient) GetBucketVersioning(ctx context.Context, bucket string) (string, error) {
+	return withRetry(ctx, client.retry, func(ctx context.Context) (string, error) {
+		req := &pb.GetBucketVersioningRequest{
+			Bucket: bucket,
+		}
+
+		resp, err := client.client.GetBucketVersioning(ctx, req)
+		if err != nil {
+			return "", fmt.Errorf("failed to get bucket versioning: %w", err)
+		}
+
+		return resp.Status, nil
+	})
+}
+
+// ListObjectVersions retrieves object versions and delete markers based on given options.
+// Versions of a key are returned newest first.
+// It returns a list of versions and an error if the operation fails.
+func (client *ACSClient) ListObjectVersions(ctx context.Context, bucket string, opts *ListObjectVersionsOptions) ([]ObjectVersion, error) {
+	return withRetry(ctx, client.retry, func(ctx context.Context) ([]ObjectVersion, error) {
+		req := &pb.ListObjectVersionsRequest{
+			Bucket: bucket,
+		}
+		if opts != nil {
+			if opts.Prefix != "" {
+				req.Prefix = &opts.Prefix
+			}
+			if opts.KeyMarker != "" {
+				req.KeyMarker = &opts.KeyMarker
+			}
+			if opts.VersionIdMarker != "" {
+				req.VersionIdMarker = &opts.VersionIdMarker
+			}
+			if opts.MaxKeys > 0 {
+				req.MaxKeys = &opts.MaxKeys
+			}
+		}
+
+		stream, err := client.client.ListObjectVersions(withSigningResource(ctx, bucket, ""), req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list object versions: %w", err)
+		}
+
+		var versions []ObjectVersion
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if v := resp.GetVersion(); v != nil {
+				versions = append(versions, ObjectVersion{
+					Key:          v.Key,
+					VersionId:    v.VersionId,
+					IsLatest:     v.IsLatest,
+					Size:         v.Size,
+					LastModified: v.LastModified.AsTime(),
+					ETag:         v.Etag,
+				})
+			}
+			if m := resp.GetDeleteMarker(); m != nil {
+				versions = append(versions, ObjectVersion{
+					Key:            m.Key,
+					VersionId:      m.VersionId,
+					IsLatest:       m.IsLatest,
+					IsDeleteMarker: true,
+					LastModified:   m.LastModified.AsTime(),
+				})
+			}
+		}
+
+		return versions, nil
+	})
+}
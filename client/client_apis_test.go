@@ -0,0 +1,65 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"testing"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPutObjectRetriesCompressedUpload(t *testing.T) {
+	threshold := compressionThreshold
+	compressionThreshold = minSampleSize
+	t.Cleanup(func() { compressionThreshold = threshold })
+
+	// The first upload is read in full and then rejected as if the service went away
+	var failed bool
+	failFirstUpload := grpc.StreamInterceptor(func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.FullMethod != pb.ObjectStorageCache_PutObject_FullMethodName || failed {
+			return handler(srv, stream)
+		}
+		failed = true
+		for {
+			if err := stream.RecvMsg(&pb.PutObjectRequest{}); err == io.EOF {
+				return status.Error(codes.Unavailable, "connection reset")
+			} else if err != nil {
+				return err
+			}
+		}
+	})
+	storage := newFakeStorage()
+	client := newTestClientWith(t, storage, []grpc.ServerOption{failFirstUpload})
+
+	data := bytes.Repeat([]byte("compressible "), 2*minSampleSize/13)
+	var mu sync.Mutex
+	var last Progress
+	progress := WithProgress(func(p Progress) {
+		mu.Lock()
+		defer mu.Unlock()
+		last = p
+	})
+	if err := client.PutObject(context.Background(), "bucket", "object", data, progress); err != nil {
+		t.Fatalf("PutObject() error = %v", err)
+	}
+
+	if !failed {
+		t.Fatal("the first upload was not rejected")
+	}
+	stored, ok := storage.object("object")
+	if !ok || !bytes.Equal(stored.data, data) {
+		t.Fatalf("stored %d bytes that differ from the %d uploaded", len(stored.data), len(data))
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if !last.Done || !last.Compressed || last.RawBytes != int64(len(data)) || last.BytesTransferred >= int64(len(data)) {
+		t.Errorf("final progress = %+v, want a compressed upload of %d raw bytes", last, len(data))
+	}
+}
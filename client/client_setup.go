@@ -9,6 +9,9 @@ import (
 	"embed"
 	"fmt"
 	"log"
+	"os"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
@@ -21,9 +24,20 @@ import (
 // It provides high-level operations for interacting with the ACS service.
 type ACSClient struct {
 	client  pb.ObjectStorageCacheClient
-	conn    *grpc.ClientConn
+	pool    *channelPool
 	retry   RetryConfig
 	session *Session
+	cache   *objectCache
+	signer  *requestSigner
+	limits  *rateLimiter
+	hedger  *hedger
+
+	batchUnsupported atomic.Bool // Set once the service rejects batch requests as unimplemented
+
+	authMu          sync.Mutex    // Serializes re-authentication and authentication with a rotated key
+	authenticatedAt time.Time     // Time of the last successful re-authentication or key rotation
+	stopRotation    chan struct{} // Closed to stop background key rotation
+	closeOnce       sync.Once
 }
 
 // Ensure compliation
@@ -52,12 +66,25 @@ func loadClientTLSCredentials() (credentials.TransportCredentials, error) {
 // It establishes a secure connection to the ACS service, loads credentials,
 // and performs initial authentication.
 func NewClient(session *Session) (*ACSClient, error) {
+	var sessionLimits *RateLimits
+	if session != nil {
+		sessionLimits = session.Limits
+	}
+	return newClient(session, newRateLimiter(sessionLimits))
+}
+
+// newClient initializes a client whose requests are throttled by limits, which may be shared with other clients.
+func newClient(session *Session, limits *rateLimiter) (*ACSClient, error) {
 	tlsCredentials, err := loadClientTLSCredentials()
 	if err != nil {
 		log.Fatalf("Failed to load TLS credentials: %v", err)
 	}
+	signer := &requestSigner{}
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(tlsCredentials),
+		grpc.WithPerRPCCredentials(signer), // Sign every request
+		grpc.WithChainUnaryInterceptor(signingUnaryInterceptor, limits.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(limits.streamInterceptor),
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallRecvMsgSize(1024*1024*1024), // 1GB
 			grpc.MaxCallSendMsgSize(1024*1024*1024), // 1GB
@@ -69,62 +96,172 @@ func NewClient(session *Session) (*ACSClient, error) {
 		}),
 	}
 
-	// Create connection
-	conn, err := grpc.NewClient(serverAddress, opts...)
+	// Create connections
+	poolConfig := ChannelPoolConfig{}
+	if session != nil && session.Pool != nil {
+		poolConfig = *session.Pool
+	}
+	pool, err := newChannelPool(serverAddress, poolConfig, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect: %v", err)
 	}
 
 	// Create client with default retry config
 	client := &ACSClient{
-		client:  pb.NewObjectStorageCacheClient(conn),
-		conn:    conn,
+		client:  pb.NewObjectStorageCacheClient(pool),
+		pool:    pool,
 		retry:   DefaultRetryConfig,
 		session: session, // Store the session
+		signer:  signer,
+		limits:  limits,
+	}
+	if session != nil {
+		client.hedger = newHedger(session.Hedging)
+		client.retry.breaker = newCircuitBreaker(session.CircuitBreaker)
+		client.retry.limiter = newAdaptiveLimiter(session.AdaptiveConcurrency)
 	}
+	client.retry.reauthenticate = client.reauthenticate
 
-	// Load credentials from disk
+	// Open the local read cache if configured
+	if session != nil && session.Cache != nil {
+		cache, err := newObjectCache(*session.Cache)
+		if err != nil {
+			client.Close()
+			return nil, fmt.Errorf("failed to open cache: %v", err)
+		}
+		client.cache = cache
+	}
+
+	// Load credentials from disk, warning once here rather than on every re-authentication
+	if os.Getenv("ACS_PROFILE") == "" {
+		fmt.Println("ACS_PROFILE environment variable not set, using 'default' profile.")
+	}
 	serviceCreds, err := loadACSCredentials()
 	if err != nil {
+		client.Close()
 		return nil, fmt.Errorf("failed to load credentials: %v", err)
 	}
 
+	// Perform authentication
+	ctx, cancel := context.WithTimeout(context.Background(), authenticateTimeout)
+	defer cancel()
+	if err := client.authenticate(ctx, serviceCreds); err != nil {
+		client.Close()
+		return nil, err
+	}
+	// After successful authentication, check if key rotation is needed
+	if err := client.RotateKey(ctx, false); err != nil {
+		// Log the error but don't fail the connection
+		fmt.Printf("Warning: Key rotation check failed: %v\n", err)
+	}
+
+	// Keep checking for rotation in the background
+	interval := defaultKeyRotationInterval
+	if session != nil && session.KeyRotationInterval != 0 {
+		interval = session.KeyRotationInterval
+	}
+	if interval > 0 {
+		client.stopRotation = make(chan struct{})
+		go client.rotateKeys(interval)
+	}
+
+	return client, nil
+}
+
+// authenticate authenticates every channel with the given credentials in the session's region.
+// The credentials are also used to sign all subsequent requests.
+func (client *ACSClient) authenticate(ctx context.Context, creds *credentialsContents) error {
+	client.signer.setCredentials(creds)
+
 	// Prepare authentication request
 	authReq := &pb.AuthRequest{
-		AccessKeyId:     serviceCreds.AccessKeyID,
-		SecretAccessKey: serviceCreds.SecretAccessKey,
+		AccessKeyId:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
 	}
 
 	// Add region if provided in session
-	if session != nil && session.Region != "" {
-		authReq.Region = &session.Region
+	if client.session != nil && client.session.Region != "" {
+		authReq.Region = &client.session.Region
 	} else {
-		DefaultRegion := "us-east-1"
-		authReq.Region = &DefaultRegion
+		region := defaultRegion
+		authReq.Region = &region
 	}
 
-	// Perform authentication
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	_, err = client.client.Authenticate(ctx, authReq)
+	// Authenticate every channel of the pool
+	for _, channel := range client.pool.channels {
+		if _, err := pb.NewObjectStorageCacheClient(channel.conn).Authenticate(ctx, authReq); err != nil {
+			return fmt.Errorf("authentication failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// reauthenticate reloads the credentials file and authenticates again.
+// It is called when an operation fails with Unauthenticated, for example after the server dropped the
+// session or another process rotated the key. Concurrent callers share a single re-authentication.
+func (client *ACSClient) reauthenticate(ctx context.Context) error {
+	requested := time.Now()
+
+	client.authMu.Lock()
+	defer client.authMu.Unlock()
+
+	// Another caller re-authenticated while this one was waiting
+	if client.authenticatedAt.After(requested) {
+		return nil
+	}
+
+	creds, err := loadACSCredentials()
 	if err != nil {
-		client.Close()
-		return nil, fmt.Errorf("authentication failed: %v", err)
+		return fmt.Errorf("failed to load credentials: %v", err)
 	}
-	// After successful authentication, check if key rotation is needed
-	if err := client.RotateKey(ctx, false); err != nil {
-		// Log the error but don't fail the connection
-		fmt.Printf("Warning: Key rotation check failed: %v\n", err)
+
+	ctx, cancel := context.WithTimeout(ctx, authenticateTimeout)
+	defer cancel()
+	if err := client.authenticate(ctx, creds); err != nil {
+		return err
 	}
 
-	return client, nil
+	client.authenticatedAt = time.Now()
+	return nil
+}
+
+// rotateKeys periodically checks whether the key needs rotating until the client is closed.
+func (client *ACSClient) rotateKeys(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-client.stopRotation:
+			return
+		case <-ticker.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), keyRotationTimeout)
+		err := client.RotateKey(ctx, false)
+		if isUnauthenticated(err) {
+			// The session was lost; re-authenticate and check again
+			if err = client.reauthenticate(ctx); err == nil {
+				err = client.RotateKey(ctx, false)
+			}
+		}
+		cancel()
+		if err != nil {
+			fmt.Printf("Warning: Key rotation check failed: %v\n", err)
+		}
+	}
 }
 
 // Close terminates the client connection.
 // It should be called when the client is no longer needed to free resources.
 func (client *ACSClient) Close() error {
-	if client.conn != nil {
-		return client.conn.Close()
+	client.closeOnce.Do(func() {
+		if client.stopRotation != nil {
+			close(client.stopRotation)
+		}
+	})
+	if client.pool != nil {
+		return client.pool.Close()
 	}
 	return nil
 }
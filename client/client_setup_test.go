@@ -0,0 +1,124 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// writeCredentials points the home directory at a temporary one holding a credentials file
+// with the given secret for the default profile.
+func writeCredentials(t *testing.T, secret string) {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("ACS_PROFILE", "")
+	if err := os.Mkdir(filepath.Join(home, ".acs"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	contents := "default:\n  access_key_id: AKTEST\n  secret_access_key: " + secret + "\n"
+	if err := os.WriteFile(filepath.Join(home, ".acs", "credentials.yaml"), []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// expireSessionOnce rejects the first request for method as Unauthenticated, as if the server had dropped the session.
+func expireSessionOnce(method string) grpc.ServerOption {
+	var once sync.Once
+	return grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		expired := false
+		if info.FullMethod == method {
+			once.Do(func() { expired = true })
+		}
+		if expired {
+			return nil, status.Error(codes.Unauthenticated, "session expired")
+		}
+		return handler(ctx, req)
+	})
+}
+
+func TestReauthenticateAfterSessionExpired(t *testing.T) {
+	writeCredentials(t, "rotated-secret")
+	storage := newFakeStorage()
+	storage.put("object", []byte("data"))
+	client := newTestClientWith(t, storage, []grpc.ServerOption{expireSessionOnce(pb.ObjectStorageCache_HeadObject_FullMethodName)})
+	client.retry.reauthenticate = client.reauthenticate
+
+	// The credentials are reloaded from disk, picking up a key rotated by another process
+	if _, err := client.HeadObject(context.Background(), "bucket", "object"); err != nil {
+		t.Fatalf("HeadObject() error = %v", err)
+	}
+	if want := []string{"rotated-secret"}; !reflect.DeepEqual(storage.secrets, want) {
+		t.Errorf("authenticated with %v, want %v", storage.secrets, want)
+	}
+	if got := storage.called("HeadObject"); got != 1 {
+		t.Errorf("HeadObject calls = %d, want 1 after the rejected attempt", got)
+	}
+	client.signer.mu.RLock()
+	defer client.signer.mu.RUnlock()
+	if secret := client.signer.creds.SecretAccessKey; secret != "rotated-secret" {
+		t.Errorf("requests are signed with %q, want the reloaded secret", secret)
+	}
+}
+
+func TestReauthenticateIsShared(t *testing.T) {
+	writeCredentials(t, "secret")
+	storage := newFakeStorage()
+	client := newTestClient(t, storage)
+
+	// Callers that fail while a re-authentication is pending wait for it instead of starting their own
+	client.authMu.Lock()
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = client.reauthenticate(context.Background())
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	client.authMu.Unlock()
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("reauthenticate() %d error = %v", i, err)
+		}
+	}
+	if got := storage.called("Authenticate"); got != 1 {
+		t.Errorf("Authenticate calls = %d, want 1", got)
+	}
+
+	// A later failure authenticates again
+	if err := client.reauthenticate(context.Background()); err != nil {
+		t.Fatalf("reauthenticate() error = %v", err)
+	}
+	if got := storage.called("Authenticate"); got != 2 {
+		t.Errorf("Authenticate calls = %d, want 2", got)
+	}
+}
+
+func TestReauthenticateFailsWithoutCredentials(t *testing.T) {
+	writeCredentials(t, "secret")
+	t.Setenv("ACS_PROFILE", "staging")
+	storage := newFakeStorage()
+	client := newTestClient(t, storage)
+
+	if err := client.reauthenticate(context.Background()); err == nil {
+		t.Error("reauthenticate() without credentials for the profile succeeded")
+	}
+	if got := storage.called("Authenticate"); got != 0 {
+		t.Errorf("Authenticate calls = %d, want 0", got)
+	}
+}
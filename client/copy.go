@@ -0,0 +1,339 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Copy constants
+const (
+	// MetadataDirectiveCopy keeps the source object's content type and user metadata
+	MetadataDirectiveCopy = "COPY"
+	// MetadataDirectiveReplace replaces the content type and user metadata with the ones in the input
+	MetadataDirectiveReplace = "REPLACE"
+
+	defaultCopyThreshold = 5 * 1024 * 1024 * 1024 // 5GB, the largest single server-side copy
+	defaultCopyPartSize  = 512 * 1024 * 1024      // 512MB parts for multipart copies
+)
+
+// ErrPreconditionFailed is returned when a conditional operation's precondition does not hold,
+// such as a copy whose source ETag no longer matches.
+var ErrPreconditionFailed = errors.New("precondition failed")
+
+// CopyObjectInput describes a server-side copy.
+type CopyObjectInput struct {
+	// SourceBucket is the bucket of the object to copy
+	SourceBucket string
+	// SourceKey is the key of the object to copy
+	SourceKey string
+	// SourceVersionID optionally selects a version of the source
+	SourceVersionID string
+	// Bucket is the destination bucket
+	Bucket string
+	// Key is the destination key
+	Key string
+
+	// MetadataDirective is MetadataDirectiveCopy (default) or MetadataDirectiveReplace
+	MetadataDirective string
+	// ContentType replaces the content type when MetadataDirective is MetadataDirectiveReplace
+	ContentType string
+	// UserMetadata replaces the user metadata when MetadataDirective is MetadataDirectiveReplace
+	UserMetadata map[string]string
+
+	// IfSourceETagMatches only copies if the source ETag equals this value
+	IfSourceETagMatches string
+
+	// ServerSideEncryption encrypts the copy with SSEAES256 or SSEKMS
+	ServerSideEncryption string
+	// SSEKMSKeyID selects the KMS key when ServerSideEncryption is SSEKMS
+	SSEKMSKeyID string
+	// SSECustomerKey encrypts the copy with a customer-provided 256-bit key
+	SSECustomerKey []byte
+	// CopySourceSSECustomerKey decrypts a source encrypted with a customer-provided key
+	CopySourceSSECustomerKey []byte
+
+	// MultipartThreshold is the source size above which a multipart copy is used (default 5GB)
+	MultipartThreshold int64
+	// PartSize is the size of each part of a multipart copy (default 512MB)
+	PartSize int64
+	// Concurrency is the number of parts copied in parallel (default 8)
+	Concurrency int
+}
+
+// CopyObjectOutput describes the object created by a copy.
+type CopyObjectOutput struct {
+	// ETag is the entity tag of the new object
+	ETag string
+	// LastModified is the modification time of the new object
+	LastModified time.Time
+	// VersionId is the version of the new object in a versioned bucket
+	VersionId string
+}
+
+// validate checks the input and applies defaults.
+func (input *CopyObjectInput) validate() error {
+	if input.SourceBucket == "" || input.SourceKey == "" {
+		return fmt.Errorf("source bucket and key are required")
+	}
+	if input.Bucket == "" || input.Key == "" {
+		return fmt.Errorf("destination bucket and key are required")
+	}
+
+	switch input.MetadataDirective {
+	case "":
+		input.MetadataDirective = MetadataDirectiveCopy
+	case MetadataDirectiveCopy, MetadataDirectiveReplace:
+	default:
+		return fmt.Errorf("invalid metadata directive %q: must be %q or %q", input.MetadataDirective, MetadataDirectiveCopy, MetadataDirectiveReplace)
+	}
+	if input.MetadataDirective == MetadataDirectiveCopy && (input.ContentType != "" || len(input.UserMetadata) > 0) {
+		return fmt.Errorf("content type and user metadata can only be set with the %q metadata directive", MetadataDirectiveReplace)
+	}
+	if input.MetadataDirective == MetadataDirectiveCopy && input.SourceBucket == input.Bucket &&
+		input.SourceKey == input.Key && input.SourceVersionID == "" {
+		return fmt.Errorf("copying an object onto itself requires the %q metadata directive", MetadataDirectiveReplace)
+	}
+
+	if err := input.encryption().validate(); err != nil {
+		return err
+	}
+
+	if input.MultipartThreshold <= 0 {
+		input.MultipartThreshold = defaultCopyThreshold
+	}
+	if input.PartSize <= 0 {
+		input.PartSize = defaultCopyPartSize
+	}
+	if input.Concurrency <= 0 {
+		input.Concurrency = defaultConcurrency
+	}
+	return nil
+}
+
+// encryption returns the encryption settings of the copy.
+func (input *CopyObjectInput) encryption() *objectEncryption {
+	return &objectEncryption{
+		algorithm:   input.ServerSideEncryption,
+		kmsKeyID:    input.SSEKMSKeyID,
+		customerKey: input.SSECustomerKey,
+		sourceKey:   input.CopySourceSSECustomerKey,
+	}
+}
+
+// copySource returns the source in the "bucket/key" form expected by the service.
+func (input *CopyObjectInput) copySource() string {
+	return input.SourceBucket + "/" + input.SourceKey
+}
+
+// CopyObjectWithInput performs a server-side copy described by input.
+// Sources larger than MultipartThreshold are copied as a multipart upload whose parts are copied in parallel;
+// if any part fails the upload is aborted.
+// It returns ErrPreconditionFailed if IfSourceETagMatches does not match the source, or if the source
+// changes while it is being copied.
+func (client *ACSClient) CopyObjectWithInput(ctx context.Context, input CopyObjectInput) (*CopyObjectOutput, error) {
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+
+	var sourceOptions []ObjectOption
+	if input.SourceVersionID != "" {
+		sourceOptions = append(sourceOptions, WithVersionID(input.SourceVersionID))
+	}
+	if input.CopySourceSSECustomerKey != nil {
+		sourceOptions = append(sourceOptions, WithSSECustomerKey(input.CopySourceSSECustomerKey))
+	}
+	source, err := client.HeadObject(ctx, input.SourceBucket, input.SourceKey, sourceOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read copy source: %w", err)
+	}
+	if input.IfSourceETagMatches != "" && input.IfSourceETagMatches != source.ETag {
+		return nil, fmt.Errorf("copy source ETag is %s, expected %s: %w", source.ETag, input.IfSourceETagMatches, ErrPreconditionFailed)
+	}
+
+	if source.ContentLength <= input.MultipartThreshold {
+		return client.copyObjectSingle(ctx, &input)
+	}
+	return client.copyObjectMultipart(ctx, &input, source)
+}
+
+// copyObjectSingle copies the object with a single CopyObject request.
+func (client *ACSClient) copyObjectSingle(ctx context.Context, input *CopyObjectInput) (*CopyObjectOutput, error) {
+	return withRetry(ctx, client.retry.singleAttempt(), func(ctx context.Context) (*CopyObjectOutput, error) {
+		req := &pb.CopyObjectRequest{
+			Bucket:            input.Bucket,
+			CopySource:        input.copySource(),
+			Key:               input.Key,
+			MetadataDirective: &input.MetadataDirective,
+		}
+		if input.SourceVersionID != "" {
+			req.CopySourceVersionId = &input.SourceVersionID
+		}
+		if input.MetadataDirective == MetadataDirectiveReplace {
+			if input.ContentType != "" {
+				req.ContentType = &input.ContentType
+			}
+			req.UserMetadata = input.UserMetadata
+		}
+		if input.IfSourceETagMatches != "" {
+			req.CopySourceIfMatch = &input.IfSourceETagMatches
+		}
+		if input.ServerSideEncryption != "" {
+			req.ServerSideEncryption = &input.ServerSideEncryption
+		}
+		if input.SSEKMSKeyID != "" {
+			req.SseKmsKeyId = &input.SSEKMSKeyID
+		}
+		req.SseCustomerKey = sseCustomerKey(input.SSECustomerKey)
+		req.CopySourceSseCustomerKey = sseCustomerKey(input.CopySourceSSECustomerKey)
+
+		resp, err := client.client.CopyObject(ctx, req)
+		if input.IfSourceETagMatches != "" && status.Code(err) == codes.FailedPrecondition {
+			// The source changed after it was checked
+			return nil, fmt.Errorf("copy source ETag does not match %s: %w", input.IfSourceETagMatches, ErrPreconditionFailed)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to copy object: %w", err)
+		}
+
+		output := &CopyObjectOutput{
+			ETag:      resp.Etag,
+			VersionId: resp.VersionId,
+		}
+		if resp.LastModified != nil {
+			output.LastModified = resp.LastModified.AsTime()
+		}
+		return output, nil
+	})
+}
+
+// copyObjectMultipart copies the object range by range into a multipart upload.
+// Every part is conditioned on the source ETag observed up front, so a source that changes mid-copy fails the copy.
+func (client *ACSClient) copyObjectMultipart(ctx context.Context, input *CopyObjectInput, source *HeadObjectOutput) (*CopyObjectOutput, error) {
+	contentType, userMetadata := source.ContentType, source.UserMetadata
+	if input.MetadataDirective == MetadataDirectiveReplace {
+		contentType, userMetadata = input.ContentType, input.UserMetadata
+	}
+
+	uploadID, err := client.createMultipartUpload(ctx, input.Bucket, input.Key, contentType, userMetadata, input.encryption())
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := client.copyParts(ctx, input, uploadID, source)
+	if err != nil {
+		if abortErr := client.abortMultipartUpload(input.Bucket, input.Key, uploadID); abortErr != nil {
+			fmt.Printf("Warning: Failed to abort multipart copy: %v\n", abortErr)
+		}
+		return nil, err
+	}
+
+	result, err := client.completeMultipartUpload(ctx, input.Bucket, input.Key, uploadID, parts)
+	if err != nil {
+		if abortErr := client.abortMultipartUpload(input.Bucket, input.Key, uploadID); abortErr != nil {
+			fmt.Printf("Warning: Failed to abort multipart copy: %v\n", abortErr)
+		}
+		return nil, err
+	}
+
+	return &CopyObjectOutput{
+		ETag:         result.etag,
+		LastModified: result.lastModified,
+		VersionId:    result.versionID,
+	}, nil
+}
+
+// copyParts copies every part of the source with a bounded number of workers.
+// The first failure cancels the remaining parts.
+func (client *ACSClient) copyParts(ctx context.Context, input *CopyObjectInput, uploadID string, source *HeadObjectOutput) ([]completedPart, error) {
+	partSize := multipartPartSize(source.ContentLength, input.PartSize)
+	partCount := int((source.ContentLength + partSize - 1) / partSize)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	parts := make([]completedPart, partCount)
+	partNumbers := make(chan int)
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	for i := 0; i < min(input.Concurrency, partCount); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range partNumbers {
+				start := int64(index) * partSize
+				end := min(start+partSize, source.ContentLength) - 1
+
+				etag, err := client.uploadPartCopy(ctx, input, uploadID, int32(index+1), start, end, source.ETag)
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				parts[index] = completedPart{PartNumber: int32(index + 1), ETag: etag}
+			}
+		}()
+	}
+
+	for index := 0; index < partCount; index++ {
+		select {
+		case partNumbers <- index:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(partNumbers)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return parts, nil
+}
+
+// uploadPartCopy copies one byte range of the source into a part of the upload.
+func (client *ACSClient) uploadPartCopy(ctx context.Context, input *CopyObjectInput, uploadID string, partNumber int32, start, end int64, sourceETag string) (string, error) {
+	return withRetry(ctx, client.retry, func(ctx context.Context) (string, error) {
+		req := &pb.UploadPartCopyRequest{
+			Bucket:            input.Bucket,
+			Key:               input.Key,
+			UploadId:          uploadID,
+			PartNumber:        partNumber,
+			CopySource:        input.copySource(),
+			CopySourceRange:   fmt.Sprintf("bytes=%d-%d", start, end),
+			CopySourceIfMatch: &sourceETag,
+		}
+		if input.SourceVersionID != "" {
+			req.CopySourceVersionId = &input.SourceVersionID
+		}
+		req.SseCustomerKey = sseCustomerKey(input.SSECustomerKey)
+		req.CopySourceSseCustomerKey = sseCustomerKey(input.CopySourceSSECustomerKey)
+
+		resp, err := client.client.UploadPartCopy(ctx, req)
+		if status.Code(err) == codes.FailedPrecondition {
+			return "", fmt.Errorf("copy source ETag changed from %s during the copy: %w", sourceETag, ErrPreconditionFailed)
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to copy part %d: %w", partNumber, err)
+		}
+
+		return resp.Etag, nil
+	})
+}
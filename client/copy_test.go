@@ -0,0 +1,195 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"google.golang.org/grpc"
+)
+
+// replaceBeforeCopy overwrites the object under key just before the first copy request reaches the service,
+// as if another writer changed it after the client checked it.
+func replaceBeforeCopy(storage *fakeStorage, key string, data []byte) grpc.ServerOption {
+	var once sync.Once
+	return grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		switch info.FullMethod {
+		case pb.ObjectStorageCache_CopyObject_FullMethodName, pb.ObjectStorageCache_UploadPartCopy_FullMethodName:
+			once.Do(func() { storage.put(key, data) })
+		}
+		return handler(ctx, req)
+	})
+}
+
+func TestCopyObjectWithInputSourceChanged(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold int64
+	}{
+		{name: "single", threshold: 0},
+		{name: "multipart", threshold: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := newFakeStorage()
+			storage.put("src", testData(2*minMultipartPart, 1))
+			source, _ := storage.object("src")
+			client := newTestClientWith(t, storage, []grpc.ServerOption{replaceBeforeCopy(storage, "src", testData(100, 2))})
+
+			// The ETag matches when it is checked, but not once the copy reaches the service
+			_, err := client.CopyObjectWithInput(context.Background(), CopyObjectInput{
+				SourceBucket:        "bucket",
+				SourceKey:           "src",
+				Bucket:              "bucket",
+				Key:                 "dst",
+				IfSourceETagMatches: source.etag,
+				MultipartThreshold:  tt.threshold,
+				PartSize:            minMultipartPart,
+			})
+			if !errors.Is(err, ErrPreconditionFailed) {
+				t.Fatalf("CopyObjectWithInput() error = %v, want ErrPreconditionFailed", err)
+			}
+			if _, ok := storage.object("dst"); ok {
+				t.Error("destination was created from a changed source")
+			}
+			if tt.threshold > 0 && storage.called("AbortMultipartUpload") != 1 {
+				t.Error("multipart copy was not aborted")
+			}
+		})
+	}
+}
+
+func TestCopyObjectInputValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   CopyObjectInput
+		wantErr string
+	}{
+		{name: "valid", input: CopyObjectInput{SourceBucket: "b", SourceKey: "a", Bucket: "b", Key: "c"}},
+		{name: "missing source", input: CopyObjectInput{SourceBucket: "b", Bucket: "b", Key: "c"}, wantErr: "source bucket and key"},
+		{name: "missing destination", input: CopyObjectInput{SourceBucket: "b", SourceKey: "a", Bucket: "b"}, wantErr: "destination bucket and key"},
+		{name: "unknown directive", input: CopyObjectInput{
+			SourceBucket: "b", SourceKey: "a", Bucket: "b", Key: "c", MetadataDirective: "MERGE",
+		}, wantErr: "invalid metadata directive"},
+		{name: "metadata without replace", input: CopyObjectInput{
+			SourceBucket: "b", SourceKey: "a", Bucket: "b", Key: "c", ContentType: "text/plain",
+		}, wantErr: "can only be set with"},
+		{name: "onto itself", input: CopyObjectInput{SourceBucket: "b", SourceKey: "a", Bucket: "b", Key: "a"}, wantErr: "onto itself"},
+		{name: "onto itself replacing metadata", input: CopyObjectInput{
+			SourceBucket: "b", SourceKey: "a", Bucket: "b", Key: "a", MetadataDirective: MetadataDirectiveReplace,
+		}},
+		{name: "onto itself from a version", input: CopyObjectInput{
+			SourceBucket: "b", SourceKey: "a", SourceVersionID: "v1", Bucket: "b", Key: "a",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validate() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	// Defaults are applied to a valid input
+	input := CopyObjectInput{SourceBucket: "b", SourceKey: "a", Bucket: "b", Key: "c"}
+	if err := input.validate(); err != nil {
+		t.Fatal(err)
+	}
+	if input.MetadataDirective != MetadataDirectiveCopy || input.MultipartThreshold != defaultCopyThreshold ||
+		input.PartSize != defaultCopyPartSize || input.Concurrency != defaultConcurrency {
+		t.Errorf("validate() defaults = %+v", input)
+	}
+}
+
+func TestCopyObjectWithInput(t *testing.T) {
+	tests := []struct {
+		name         string
+		threshold    int64
+		parts        int
+		directive    string
+		contentType  string
+		userMetadata map[string]string
+	}{
+		{name: "single", contentType: "text/csv", userMetadata: map[string]string{"owner": "analytics"}},
+		{name: "single replacing metadata", directive: MetadataDirectiveReplace, contentType: "text/plain", userMetadata: map[string]string{"owner": "ops"}},
+		{name: "multipart", threshold: 1, parts: 3, contentType: "text/csv", userMetadata: map[string]string{"owner": "analytics"}},
+		{name: "multipart replacing metadata", threshold: 1, parts: 3, directive: MetadataDirectiveReplace, contentType: "text/plain", userMetadata: map[string]string{"owner": "ops"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := newFakeStorage()
+			object := testData(2*minMultipartPart+100, 1)
+			storage.putObject("src", fakeObject{data: object, contentType: "text/csv", userMetadata: map[string]string{"owner": "analytics"}})
+			source, _ := storage.object("src")
+			client := newTestClient(t, storage)
+
+			input := CopyObjectInput{
+				SourceBucket:        "bucket",
+				SourceKey:           "src",
+				Bucket:              "bucket",
+				Key:                 "dst",
+				IfSourceETagMatches: source.etag,
+				MultipartThreshold:  tt.threshold,
+				PartSize:            minMultipartPart,
+				MetadataDirective:   tt.directive,
+			}
+			if tt.directive == MetadataDirectiveReplace {
+				input.ContentType, input.UserMetadata = tt.contentType, tt.userMetadata
+			}
+			output, err := client.CopyObjectWithInput(context.Background(), input)
+			if err != nil {
+				t.Fatalf("CopyObjectWithInput() error = %v", err)
+			}
+
+			copied, ok := storage.object("dst")
+			if !ok {
+				t.Fatal("destination was not created")
+			}
+			if !bytes.Equal(copied.data, object) {
+				t.Errorf("destination holds %d bytes that differ from the source", len(copied.data))
+			}
+			if output.ETag != copied.etag {
+				t.Errorf("CopyObjectWithInput() ETag = %q, want %q", output.ETag, copied.etag)
+			}
+			if copied.contentType != tt.contentType || !reflect.DeepEqual(copied.userMetadata, tt.userMetadata) {
+				t.Errorf("destination metadata = %q %v, want %q %v", copied.contentType, copied.userMetadata, tt.contentType, tt.userMetadata)
+			}
+			if got := storage.called("UploadPartCopy"); got != tt.parts {
+				t.Errorf("UploadPartCopy calls = %d, want %d", got, tt.parts)
+			}
+		})
+	}
+}
+
+func TestCopyObjectWithInputChecksSourceETag(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("src", testData(100, 1))
+	client := newTestClient(t, storage)
+
+	_, err := client.CopyObjectWithInput(context.Background(), CopyObjectInput{
+		SourceBucket:        "bucket",
+		SourceKey:           "src",
+		Bucket:              "bucket",
+		Key:                 "dst",
+		IfSourceETagMatches: "stale",
+	})
+	if !errors.Is(err, ErrPreconditionFailed) {
+		t.Fatalf("CopyObjectWithInput() error = %v, want ErrPreconditionFailed", err)
+	}
+	if got := storage.called("CopyObject"); got != 0 {
+		t.Errorf("CopyObject calls = %d, want 0", got)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Credential file line patterns used to edit a profile in place
+var (
+	// profileHeaderPattern matches a top-level profile key, optionally quoted, e.g. `default:` or `"prod": # comment`
+	profileHeaderPattern = regexp.MustCompile(`^(['"]?)([^'"#]+?)(['"]?)\s*:\s*(#.*)?$`)
+	// secretLinePattern matches an indented secret_access_key entry, capturing the prefix and any trailing comment
+	secretLinePattern = regexp.MustCompile(`^(\s+secret_access_key\s*:\s*)(?:"[^"]*"|'[^']*'|[^#\s]*)(\s+#.*)?$`)
+)
+
+// credentialsFilePath returns the path of the credentials file, ~/.acs/credentials.yaml.
+func credentialsFilePath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %v", err)
+	}
+	return filepath.Join(homeDir, ".acs", "credentials.yaml"), nil
+}
+
+// currentProfile returns the profile selected by ACS_PROFILE, defaulting to "default".
+func currentProfile() string {
+	profile := os.Getenv("ACS_PROFILE")
+	if profile == "" {
+		profile = "default"
+	}
+	return profile
+}
+
+// updateProfileSecret returns the credentials file contents with the secret of one profile replaced.
+// The line holding the secret is edited in place so comments, ordering and other profiles are preserved.
+// If the file's layout is not recognized it returns an error rather than re-encoding the whole file,
+// which would drop comments, ordering and any fields the SDK does not know about.
+func updateProfileSecret(data []byte, profile, secret string) ([]byte, error) {
+	var profiles profileCredentials
+	if err := yaml.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal credentials: %v", err)
+	}
+	if _, ok := profiles[profile]; !ok {
+		return nil, fmt.Errorf("profile '%s' not found in credentials file", profile)
+	}
+
+	expected := make(profileCredentials, len(profiles))
+	for name, creds := range profiles {
+		expected[name] = creds
+	}
+	creds := expected[profile]
+	creds.SecretAccessKey = secret
+	expected[profile] = creds
+
+	// Only accept the in-place edit if it decodes to exactly the expected profiles
+	updated, ok := replaceSecretLine(data, profile, secret)
+	if ok {
+		var check profileCredentials
+		ok = yaml.Unmarshal(updated, &check) == nil && reflect.DeepEqual(check, expected)
+	}
+	if !ok {
+		return nil, fmt.Errorf("cannot update secret of profile '%s': secret_access_key line not recognized in credentials file", profile)
+	}
+	return updated, nil
+}
+
+// replaceSecretLine rewrites the secret_access_key line inside the block of the given profile.
+func replaceSecretLine(data []byte, profile, secret string) ([]byte, bool) {
+	encoded, err := yaml.Marshal(secret)
+	if err != nil {
+		return nil, false
+	}
+	value := strings.TrimSuffix(string(encoded), "\n")
+	if strings.Contains(value, "\n") {
+		return nil, false
+	}
+
+	lines := strings.SplitAfter(string(data), "\n")
+	inProfile := false
+	for i, line := range lines {
+		content := strings.TrimRight(line, "\r\n")
+		trimmed := strings.TrimSpace(content)
+
+		// A non-indented, non-comment line starts a new top-level key
+		if content != "" && content[0] != ' ' && content[0] != '\t' && !strings.HasPrefix(trimmed, "#") {
+			match := profileHeaderPattern.FindStringSubmatch(content)
+			inProfile = match != nil && match[1] == match[3] && match[2] == profile
+			continue
+		}
+		if !inProfile {
+			continue
+		}
+
+		if match := secretLinePattern.FindStringSubmatch(content); match != nil {
+			lines[i] = match[1] + value + match[2] + line[len(content):]
+			return []byte(strings.Join(lines, "")), true
+		}
+	}
+
+	return nil, false
+}
+
+// writeFileSynced atomically replaces path with data: it writes a temporary file in the same directory,
+// fsyncs it, renames it over path and fsyncs the directory, so a crash leaves either the old or the new file.
+func writeFileSynced(path string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+
+	// Persist the rename itself
+	if d, err := os.Open(dir); err == nil {
+		d.Sync()
+		d.Close()
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUpdateProfileSecret(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile string
+		secret  string
+		file    string
+		want    string
+		wantErr string
+	}{
+		{
+			name:    "keeps comments and other profiles",
+			profile: "prod",
+			file: `# ACS credentials
+default:
+  access_key_id: AKDEFAULT
+  secret_access_key: old-default # rotated monthly
+"prod": # production
+  access_key_id: AKPROD
+  secret_access_key: "old-prod"
+`,
+			want: `# ACS credentials
+default:
+  access_key_id: AKDEFAULT
+  secret_access_key: old-default # rotated monthly
+"prod": # production
+  access_key_id: AKPROD
+  secret_access_key: new/secret+key
+`,
+		},
+		{
+			name:    "keeps a trailing comment",
+			profile: "default",
+			file:    "default:\n  secret_access_key: old # rotated monthly\n  access_key_id: AKDEFAULT\n",
+			want:    "default:\n  secret_access_key: new/secret+key # rotated monthly\n  access_key_id: AKDEFAULT\n",
+		},
+		{
+			name:    "keeps CRLF line endings",
+			profile: "default",
+			file:    "default:\r\n  access_key_id: AKDEFAULT\r\n  secret_access_key: old\r\n",
+			want:    "default:\r\n  access_key_id: AKDEFAULT\r\n  secret_access_key: new/secret+key\r\n",
+		},
+		{
+			name:    "quotes secrets YAML would misread",
+			profile: "default",
+			secret:  "123",
+			file:    "default:\n  access_key_id: AKDEFAULT\n  secret_access_key: old\n",
+			want:    "default:\n  access_key_id: AKDEFAULT\n  secret_access_key: \"123\"\n",
+		},
+		{
+			name:    "missing profile",
+			profile: "staging",
+			file:    "default:\n  access_key_id: AKDEFAULT\n  secret_access_key: old\n",
+			wantErr: "profile 'staging' not found",
+		},
+		{
+			name:    "flow mapping",
+			profile: "default",
+			file:    "default: {access_key_id: AKDEFAULT, secret_access_key: old}\n",
+			wantErr: "not recognized",
+		},
+		{
+			name:    "invalid YAML",
+			profile: "default",
+			file:    "default: [\n",
+			wantErr: "failed to unmarshal",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := tt.secret
+			if secret == "" {
+				secret = "new/secret+key"
+			}
+
+			got, err := updateProfileSecret([]byte(tt.file), tt.profile, secret)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("updateProfileSecret() error = %v, want it to mention %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("updateProfileSecret() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("updateProfileSecret() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteFileSynced(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "credentials.yaml")
+	if err := os.WriteFile(path, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writeFileSynced(path, []byte("new"), 0600); err != nil {
+		t.Fatalf("writeFileSynced() error = %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != "new" {
+		t.Errorf("file contents = %q, %v; want %q", data, err, "new")
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("file mode = %v, %v; want 0600", info.Mode().Perm(), err)
+	}
+
+	// No temporary files are left behind
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("directory holds %d files, want 1", len(entries))
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+)
+
+// Server-side encryption algorithms
+const (
+	// SSEAES256 encrypts objects with keys managed by the service
+	SSEAES256 = "AES256"
+	// SSEKMS encrypts objects with a key held in the key management service
+	SSEKMS = "aws:kms"
+
+	sseCustomerKeyLength = 32 // Customer-provided keys are 256-bit AES keys
+)
+
+// BucketEncryption is the default server-side encryption applied to new objects in a bucket.
+type BucketEncryption struct {
+	// ServerSideEncryption is SSEAES256 or SSEKMS
+	ServerSideEncryption string
+	// KMSKeyID selects the KMS key when ServerSideEncryption is SSEKMS; the service default key is used if empty
+	KMSKeyID string
+}
+
+// objectEncryption holds the server-side encryption settings of a request.
+type objectEncryption struct {
+	algorithm   string // SSEAES256 or SSEKMS for service-side keys
+	kmsKeyID    string
+	customerKey []byte // SSE-C key for the object itself
+	sourceKey   []byte // SSE-C key for a copy source
+}
+
+// WithServerSideEncryption encrypts an object written by PutObject or CopyObject with service-managed keys.
+func WithServerSideEncryption() ObjectOption {
+	return func(opts *ObjectOptions) {
+		opts.set |= optServerSideEncryption
+		opts.encryption.algorithm = SSEAES256
+	}
+}
+
+// WithSSEKMS encrypts an object written by PutObject or CopyObject with a KMS key.
+// An empty keyID uses the service's default KMS key.
+func WithSSEKMS(keyID string) ObjectOption {
+	return func(opts *ObjectOptions) {
+		opts.set |= optServerSideEncryption
+		opts.encryption.algorithm = SSEKMS
+		opts.encryption.kmsKeyID = keyID
+	}
+}
+
+// WithSSECustomerKey encrypts an object with a customer-provided 256-bit key (SSE-C).
+// The service does not store the key, so the same key must be passed to every PutObject, GetObject,
+// HeadObject and CopyObject of the object. GetObject bypasses the local cache for such objects.
+func WithSSECustomerKey(key []byte) ObjectOption {
+	return func(opts *ObjectOptions) {
+		opts.set |= optCustomerKey
+		opts.encryption.customerKey = key
+	}
+}
+
+// WithCopySourceSSECustomerKey supplies the customer-provided key of a CopyObject source encrypted with SSE-C.
+func WithCopySourceSSECustomerKey(key []byte) ObjectOption {
+	return func(opts *ObjectOptions) {
+		opts.set |= optCopySourceCustomerKey
+		opts.encryption.sourceKey = key
+	}
+}
+
+// validateEncryption checks a combination of server-side encryption settings.
+func validateEncryption(algorithm, kmsKeyID string, customerKey, sourceKey []byte) error {
+	switch algorithm {
+	case "", SSEAES256, SSEKMS:
+	default:
+		return fmt.Errorf("invalid server-side encryption %q: must be %q or %q", algorithm, SSEAES256, SSEKMS)
+	}
+	if kmsKeyID != "" && algorithm != SSEKMS {
+		return fmt.Errorf("a KMS key ID requires %q server-side encryption", SSEKMS)
+	}
+	if customerKey != nil {
+		if algorithm != "" {
+			return fmt.Errorf("a customer-provided key cannot be combined with %q server-side encryption", algorithm)
+		}
+		if len(customerKey) != sseCustomerKeyLength {
+			return fmt.Errorf("customer-provided key must be %d bytes, got %d", sseCustomerKeyLength, len(customerKey))
+		}
+	}
+	if sourceKey != nil && len(sourceKey) != sseCustomerKeyLength {
+		return fmt.Errorf("copy source customer-provided key must be %d bytes, got %d", sseCustomerKeyLength, len(sourceKey))
+	}
+	return nil
+}
+
+// validate checks the encryption options of a request.
+func (encryption *objectEncryption) validate() error {
+	return validateEncryption(encryption.algorithm, encryption.kmsKeyID, encryption.customerKey, encryption.sourceKey)
+}
+
+// sseCustomerKey returns the wire representation of a customer-provided key, or nil if key is empty.
+func sseCustomerKey(key []byte) *pb.SSECustomerKey {
+	if len(key) == 0 {
+		return nil
+	}
+	sum := md5.Sum(key)
+	return &pb.SSECustomerKey{
+		Algorithm: SSEAES256,
+		Key:       key,
+		KeyMd5:    base64.StdEncoding.EncodeToString(sum[:]),
+	}
+}
+
+// PutBucketEncryption sets the default server-side encryption of a bucket.
+// It applies to new objects written without their own encryption settings.
+// It returns an error if validation or the operation fails.
+func (client *ACSClient) PutBucketEncryption(ctx context.Context, bucket string, encryption BucketEncryption) error {
+	if encryption.ServerSideEncryption == "" {
+		return fmt.Errorf("bucket encryption must specify a server-side encryption algorithm")
+	}
+	if err := validateEncryption(encryption.ServerSideEncryption, encryption.KMSKeyID, nil, nil); err != nil {
+		return err
+	}
+
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		req := &pb.PutBucketEncryptionRequest{
+			Bucket: bucket,
+			Encryption: &pb.BucketEncryption{
+				ServerSideEncryption: encryption.ServerSideEncryption,
+			},
+		}
+		if encryption.KMSKeyID != "" {
+			req.Encryption.KmsKeyId = &encryption.KMSKeyID
+		}
+
+		_, err := client.client.PutBucketEncryption(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to put bucket encryption: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetBucketEncryption retrieves the default server-side encryption of a bucket.
+// It returns nil if the bucket has no default encryption, and an error if the operation fails.
+func (client *ACSClient) GetBucketEncryption(ctx context.Context, bucket string) (*BucketEncryption, error) {
+	return withRetry(ctx, client.retry, func(ctx context.Context) (*BucketEncryption, error) {
+		req := &pb.GetBucketEncryptionRequest{
+			Bucket: bucket,
+		}
+
+		resp, err := client.client.GetBucketEncryption(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bucket encryption: %w", err)
+		}
+
+		if resp.Encryption == nil {
+			return nil, nil
+		}
+		return &BucketEncryption{
+			ServerSideEncryption: resp.Encryption.ServerSideEncryption,
+			KMSKeyID:             resp.Encryption.GetKmsKeyId(),
+		}, nil
+	})
+}
+
+// DeleteBucketEncryption removes the default server-side encryption of a bucket.
+// Existing objects keep their encryption.
+// It returns an error if the operation fails.
+func (client *ACSClient) DeleteBucketEncryption(ctx context.Context, bucket string) error {
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		req := &pb.DeleteBucketEncryptionRequest{
+			Bucket: bucket,
+		}
+
+		_, err := client.client.DeleteBucketEncryption(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to delete bucket encryption: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestValidateEncryption(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, sseCustomerKeyLength)
+	tests := []struct {
+		name       string
+		encryption objectEncryption
+		wantErr    string
+	}{
+		{name: "none", encryption: objectEncryption{}},
+		{name: "aes256", encryption: objectEncryption{algorithm: SSEAES256}},
+		{name: "kms default key", encryption: objectEncryption{algorithm: SSEKMS}},
+		{name: "kms key", encryption: objectEncryption{algorithm: SSEKMS, kmsKeyID: "key-1"}},
+		{name: "customer key", encryption: objectEncryption{customerKey: key}},
+		{name: "customer keys for a copy", encryption: objectEncryption{customerKey: key, sourceKey: key}},
+		{name: "source key with aes256", encryption: objectEncryption{algorithm: SSEAES256, sourceKey: key}},
+		{name: "algorithm", encryption: objectEncryption{algorithm: "aes256"}, wantErr: "invalid server-side encryption"},
+		{name: "kms key without kms", encryption: objectEncryption{algorithm: SSEAES256, kmsKeyID: "key-1"}, wantErr: "requires \"aws:kms\""},
+		{name: "customer key with aes256", encryption: objectEncryption{algorithm: SSEAES256, customerKey: key}, wantErr: "cannot be combined"},
+		{name: "customer key with kms", encryption: objectEncryption{algorithm: SSEKMS, customerKey: key}, wantErr: "cannot be combined"},
+		{name: "short customer key", encryption: objectEncryption{customerKey: key[:16]}, wantErr: "must be 32 bytes, got 16"},
+		{name: "empty customer key", encryption: objectEncryption{customerKey: []byte{}}, wantErr: "must be 32 bytes, got 0"},
+		{name: "short source key", encryption: objectEncryption{sourceKey: key[:31]}, wantErr: "copy source customer-provided key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.encryption.validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validate() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSSECustomerKey(t *testing.T) {
+	if got := sseCustomerKey(nil); got != nil {
+		t.Errorf("sseCustomerKey(nil) = %v, want nil", got)
+	}
+
+	key := sseCustomerKey(bytes.Repeat([]byte{0}, sseCustomerKeyLength))
+	// The MD5 of 32 zero bytes, base64-encoded
+	if key.Algorithm != SSEAES256 || key.KeyMd5 != "cLyPS3KoaSFGi/joRB3OUQ==" || len(key.Key) != sseCustomerKeyLength {
+		t.Errorf("sseCustomerKey() = %v", key)
+	}
+}
+
+func TestEncryptionValidatesLocally(t *testing.T) {
+	storage := newFakeStorage()
+	client := newTestClient(t, storage)
+	ctx := context.Background()
+	key := bytes.Repeat([]byte{1}, sseCustomerKeyLength)
+
+	err := client.PutObject(ctx, "bucket", "object", []byte("data"), WithServerSideEncryption(), WithSSECustomerKey(key))
+	if err == nil || !strings.Contains(err.Error(), "cannot be combined") {
+		t.Errorf("PutObject() with two kinds of encryption error = %v, want the conflict reported", err)
+	}
+	if err := client.PutObject(ctx, "bucket", "object", []byte("data"), WithSSECustomerKey(key[:8])); err == nil || !strings.Contains(err.Error(), "must be 32 bytes") {
+		t.Errorf("PutObject() with a short key error = %v, want the key length reported", err)
+	}
+	if got := storage.called("PutObject"); got != 0 {
+		t.Errorf("PutObject calls = %d, want none", got)
+	}
+
+	// The fake does not implement bucket encryption, so only a local error names the problem
+	if err := client.PutBucketEncryption(ctx, "bucket", BucketEncryption{}); err == nil || !strings.Contains(err.Error(), "must specify") {
+		t.Errorf("PutBucketEncryption() without an algorithm error = %v, want it reported", err)
+	}
+	if err := client.PutBucketEncryption(ctx, "bucket", BucketEncryption{ServerSideEncryption: SSEAES256, KMSKeyID: "key-1"}); err == nil || !strings.Contains(err.Error(), "requires") {
+		t.Errorf("PutBucketEncryption() with a KMS key for AES256 error = %v, want it reported", err)
+	}
+}
@@ -0,0 +1,432 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeObject is an object held by fakeStorage.
+type fakeObject struct {
+	data         []byte
+	etag         string
+	lastModified time.Time
+	contentType  string
+	userMetadata map[string]string
+	tags         map[string]string
+}
+
+// fakeUpload is a multipart upload in progress.
+type fakeUpload struct {
+	key          string
+	contentType  string
+	userMetadata map[string]string
+	parts        map[int32][]byte
+}
+
+// fakeStorage is an in-memory ObjectStorageCache service holding the objects of a single bucket.
+type fakeStorage struct {
+	pb.UnimplementedObjectStorageCacheServer
+
+	mu      sync.Mutex
+	objects map[string]fakeObject
+	uploads map[string]*fakeUpload // Upload ID to upload
+	calls   map[string]int         // Number of calls per RPC
+	secrets []string               // Secret keys of every Authenticate call
+}
+
+func newFakeStorage() *fakeStorage {
+	return &fakeStorage{
+		objects: make(map[string]fakeObject),
+		uploads: make(map[string]*fakeUpload),
+		calls:   make(map[string]int),
+	}
+}
+
+// put stores an object, replacing any previous version.
+func (s *fakeStorage) put(key string, data []byte) {
+	s.putObject(key, fakeObject{data: data})
+}
+
+// putObject stores an object with its metadata, computing its ETag and modification time.
+func (s *fakeStorage) putObject(key string, obj fakeObject) {
+	sum := md5.Sum(obj.data)
+	obj.etag = hex.EncodeToString(sum[:])
+	obj.lastModified = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = obj
+}
+
+// object returns the object stored under key.
+func (s *fakeStorage) object(key string) (fakeObject, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.objects[key]
+	return obj, ok
+}
+
+// called returns the number of calls made to an RPC.
+func (s *fakeStorage) called(rpc string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[rpc]
+}
+
+// lookup records a call and returns the object stored under key.
+func (s *fakeStorage) lookup(rpc, key string) (fakeObject, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[rpc]++
+	obj, ok := s.objects[key]
+	if !ok {
+		return fakeObject{}, status.Errorf(codes.NotFound, "no such key %q", key)
+	}
+	return obj, nil
+}
+
+func (s *fakeStorage) Authenticate(ctx context.Context, req *pb.AuthRequest) (*pb.AuthResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls["Authenticate"]++
+	s.secrets = append(s.secrets, req.SecretAccessKey)
+	return &pb.AuthResponse{}, nil
+}
+
+func (s *fakeStorage) HeadObject(ctx context.Context, req *pb.HeadObjectRequest) (*pb.HeadObjectResponse, error) {
+	obj, err := s.lookup("HeadObject", req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.HeadObjectResponse{Metadata: &pb.ObjectMetadata{
+		Size:         int64(len(obj.data)),
+		Etag:         obj.etag,
+		LastModified: timestamppb.New(obj.lastModified),
+		ContentType:  obj.contentType,
+		UserMetadata: obj.userMetadata,
+		TagCount:     int32(len(obj.tags)),
+	}}, nil
+}
+
+func (s *fakeStorage) PutObject(stream pb.ObjectStorageCache_PutObjectServer) error {
+	var input *pb.PutObjectInput
+	var data []byte
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if params := req.GetParameters(); params != nil {
+			input = params
+		}
+		data = append(data, req.GetChunk()...)
+	}
+	if input.GetIsCompressed() {
+		decompressed, err := decompressData(data)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		data = decompressed
+	}
+
+	s.mu.Lock()
+	s.calls["PutObject"]++
+	s.mu.Unlock()
+	s.putObject(input.Key, fakeObject{data: data, contentType: input.GetContentType(), userMetadata: input.UserMetadata, tags: input.Tags})
+	return stream.SendAndClose(&pb.PutObjectResponse{})
+}
+
+func (s *fakeStorage) GetObject(req *pb.GetObjectRequest, stream pb.ObjectStorageCache_GetObjectServer) error {
+	obj, err := s.lookup("GetObject", req.Key)
+	if err != nil {
+		return err
+	}
+	if req.IfMatch != nil && *req.IfMatch != obj.etag {
+		return status.Errorf(codes.FailedPrecondition, "ETag %q does not match", *req.IfMatch)
+	}
+
+	data := obj.data
+	if req.Range != nil {
+		start, end, err := parseRangeSpec(*req.Range, int64(len(data)))
+		if err != nil {
+			return status.Error(codes.OutOfRange, err.Error())
+		}
+		data = data[start : end+1]
+	}
+
+	if err := stream.Send(&pb.GetObjectResponse{Data: &pb.GetObjectResponse_Metadata{Metadata: &pb.GetObjectMetadata{}}}); err != nil {
+		return err
+	}
+	for len(data) > 0 {
+		n := min(len(data), 1024)
+		if err := stream.Send(&pb.GetObjectResponse{Data: &pb.GetObjectResponse_Chunk{Chunk: data[:n]}}); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return nil
+}
+
+func (s *fakeStorage) ListObjects(req *pb.ListObjectsRequest, stream pb.ObjectStorageCache_ListObjectsServer) error {
+	s.mu.Lock()
+	s.calls["ListObjects"]++
+	var keys []string
+	for key := range s.objects {
+		if strings.HasPrefix(key, req.GetPrefix()) && key > req.GetStartAfter() {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if req.MaxKeys != nil && len(keys) > int(*req.MaxKeys) {
+		keys = keys[:*req.MaxKeys]
+	}
+	summaries := make([]*pb.ObjectSummary, len(keys))
+	for i, key := range keys {
+		obj := s.objects[key]
+		summaries[i] = &pb.ObjectSummary{
+			Key:          key,
+			Size:         int64(len(obj.data)),
+			Etag:         obj.etag,
+			LastModified: timestamppb.New(obj.lastModified),
+		}
+	}
+	s.mu.Unlock()
+
+	for _, summary := range summaries {
+		if err := stream.Send(&pb.ListObjectsResponse{Data: &pb.ListObjectsResponse_Object{Object: summary}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *fakeStorage) DeleteObject(ctx context.Context, req *pb.DeleteObjectRequest) (*pb.DeleteObjectResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls["DeleteObject"]++
+	delete(s.objects, req.Key)
+	return &pb.DeleteObjectResponse{}, nil
+}
+
+func (s *fakeStorage) DeleteObjects(ctx context.Context, req *pb.DeleteObjectsRequest) (*pb.DeleteObjectsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls["DeleteObjects"]++
+	resp := &pb.DeleteObjectsResponse{}
+	for _, obj := range req.Objects {
+		delete(s.objects, obj.Key)
+		resp.DeletedObjects = append(resp.DeletedObjects, &pb.DeletedObject{Key: obj.Key})
+	}
+	return resp, nil
+}
+
+func (s *fakeStorage) CopyObject(ctx context.Context, req *pb.CopyObjectRequest) (*pb.CopyObjectResponse, error) {
+	_, sourceKey, _ := strings.Cut(req.CopySource, "/")
+	source, err := s.lookup("CopyObject", sourceKey)
+	if err != nil {
+		return nil, err
+	}
+	if req.CopySourceIfMatch != nil && *req.CopySourceIfMatch != source.etag {
+		return nil, status.Errorf(codes.FailedPrecondition, "ETag %q does not match", *req.CopySourceIfMatch)
+	}
+	if req.GetMetadataDirective() == MetadataDirectiveReplace {
+		source.contentType, source.userMetadata = req.GetContentType(), req.UserMetadata
+	}
+	s.putObject(req.Key, source)
+	copied, _ := s.object(req.Key)
+	return &pb.CopyObjectResponse{Etag: copied.etag, LastModified: timestamppb.New(copied.lastModified)}, nil
+}
+
+func (s *fakeStorage) GetObjectTagging(ctx context.Context, req *pb.GetObjectTaggingRequest) (*pb.GetObjectTaggingResponse, error) {
+	obj, err := s.lookup("GetObjectTagging", req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetObjectTaggingResponse{Tags: obj.tags}, nil
+}
+
+func (s *fakeStorage) PutObjectTagging(ctx context.Context, req *pb.PutObjectTaggingRequest) (*pb.PutObjectTaggingResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls["PutObjectTagging"]++
+	obj, ok := s.objects[req.Key]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no such key %q", req.Key)
+	}
+	obj.tags = req.Tags
+	s.objects[req.Key] = obj
+	return &pb.PutObjectTaggingResponse{}, nil
+}
+
+func (s *fakeStorage) DeleteObjectTagging(ctx context.Context, req *pb.DeleteObjectTaggingRequest) (*pb.DeleteObjectTaggingResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls["DeleteObjectTagging"]++
+	obj, ok := s.objects[req.Key]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no such key %q", req.Key)
+	}
+	obj.tags = nil
+	s.objects[req.Key] = obj
+	return &pb.DeleteObjectTaggingResponse{}, nil
+}
+
+func (s *fakeStorage) CreateMultipartUpload(ctx context.Context, req *pb.CreateMultipartUploadRequest) (*pb.CreateMultipartUploadResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls["CreateMultipartUpload"]++
+	uploadID := fmt.Sprintf("upload-%d", len(s.uploads)+1)
+	s.uploads[uploadID] = &fakeUpload{
+		key:          req.Key,
+		contentType:  req.GetContentType(),
+		userMetadata: req.UserMetadata,
+		parts:        make(map[int32][]byte),
+	}
+	return &pb.CreateMultipartUploadResponse{UploadId: uploadID}, nil
+}
+
+func (s *fakeStorage) UploadPart(stream pb.ObjectStorageCache_UploadPartServer) error {
+	var input *pb.UploadPartInput
+	var data []byte
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if params := req.GetParameters(); params != nil {
+			input = params
+		}
+		data = append(data, req.GetChunk()...)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls["UploadPart"]++
+	upload, ok := s.uploads[input.UploadId]
+	if !ok {
+		return status.Errorf(codes.NotFound, "no such upload %q", input.UploadId)
+	}
+	upload.parts[input.PartNumber] = data
+	sum := md5.Sum(data)
+	return stream.SendAndClose(&pb.UploadPartResponse{Etag: hex.EncodeToString(sum[:])})
+}
+
+func (s *fakeStorage) UploadPartCopy(ctx context.Context, req *pb.UploadPartCopyRequest) (*pb.UploadPartCopyResponse, error) {
+	_, sourceKey, _ := strings.Cut(req.CopySource, "/")
+	source, err := s.lookup("UploadPartCopy", sourceKey)
+	if err != nil {
+		return nil, err
+	}
+	if req.CopySourceIfMatch != nil && *req.CopySourceIfMatch != source.etag {
+		return nil, status.Errorf(codes.FailedPrecondition, "ETag %q does not match", *req.CopySourceIfMatch)
+	}
+	var start, end int
+	if _, err := fmt.Sscanf(req.CopySourceRange, "bytes=%d-%d", &start, &end); err != nil || end >= len(source.data) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid range %q", req.CopySourceRange)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	upload, ok := s.uploads[req.UploadId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no such upload %q", req.UploadId)
+	}
+	data := source.data[start : end+1]
+	upload.parts[req.PartNumber] = data
+	sum := md5.Sum(data)
+	return &pb.UploadPartCopyResponse{Etag: hex.EncodeToString(sum[:])}, nil
+}
+
+func (s *fakeStorage) AbortMultipartUpload(ctx context.Context, req *pb.AbortMultipartUploadRequest) (*pb.AbortMultipartUploadResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls["AbortMultipartUpload"]++
+	if _, ok := s.uploads[req.UploadId]; !ok {
+		return nil, status.Errorf(codes.NotFound, "no such upload %q", req.UploadId)
+	}
+	delete(s.uploads, req.UploadId)
+	return &pb.AbortMultipartUploadResponse{}, nil
+}
+
+func (s *fakeStorage) CompleteMultipartUpload(ctx context.Context, req *pb.CompleteMultipartUploadRequest) (*pb.CompleteMultipartUploadResponse, error) {
+	s.mu.Lock()
+	s.calls["CompleteMultipartUpload"]++
+	upload, ok := s.uploads[req.UploadId]
+	delete(s.uploads, req.UploadId)
+	s.mu.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no such upload %q", req.UploadId)
+	}
+
+	var data []byte
+	for _, part := range req.Parts {
+		data = append(data, upload.parts[part.PartNumber]...)
+	}
+	s.putObject(upload.key, fakeObject{data: data, contentType: upload.contentType, userMetadata: upload.userMetadata})
+	obj, _ := s.object(upload.key)
+	return &pb.CompleteMultipartUploadResponse{Etag: obj.etag}, nil
+}
+
+// newTestClient serves storage over an in-memory connection and returns a client using it.
+func newTestClient(t *testing.T, storage pb.ObjectStorageCacheServer) *ACSClient {
+	t.Helper()
+	return newTestClientWith(t, storage, nil)
+}
+
+// newTestClientWith is newTestClient with additional server and dial options.
+func newTestClientWith(t *testing.T, storage pb.ObjectStorageCacheServer, serverOptions []grpc.ServerOption, dialOptions ...grpc.DialOption) *ACSClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	// Accept messages as large as the client sends, like the service does
+	server := grpc.NewServer(append([]grpc.ServerOption{grpc.MaxRecvMsgSize(1024 * 1024 * 1024)}, serverOptions...)...)
+	pb.RegisterObjectStorageCacheServer(server, storage)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	dialOptions = append([]grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}, dialOptions...)
+	conn, err := grpc.NewClient("passthrough:///bufnet", dialOptions...)
+	if err != nil {
+		t.Fatalf("failed to connect to test server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	retry := DefaultRetryConfig
+	retry.InitialBackoff = time.Millisecond
+	pool := &channelPool{channels: []*pooledChannel{{conn: conn}}, policy: PoolRoundRobin}
+	return &ACSClient{
+		client: pb.NewObjectStorageCacheClient(pool),
+		pool:   pool,
+		retry:  retry,
+		signer: &requestSigner{},
+		limits: newRateLimiter(nil),
+	}
+}
@@ -0,0 +1,402 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fsReadBlockSize is the size of each ranged GetObject issued while reading a file.
+const fsReadBlockSize = 4 * 1024 * 1024 // 4MB
+
+// afterPrefix sorts after any key continuing a prefix in practice; it is the largest code point,
+// since the service only accepts valid UTF-8 keys.
+const afterPrefix = "\U0010FFFF"
+
+// BucketFS is a read-only fs.FS view of a bucket.
+// Object keys are treated as slash-separated paths and key prefixes are presented as directories.
+type BucketFS struct {
+	ctx    context.Context
+	client *ACSClient
+	bucket string
+}
+
+// Ensure BucketFS implements the optional fs interfaces
+var (
+	_ fs.ReadDirFS = (*BucketFS)(nil)
+	_ fs.StatFS    = (*BucketFS)(nil)
+)
+
+// BucketFS returns a read-only fs.FS backed by the given bucket.
+// The context is used for every request issued through the file system and its files.
+func (client *ACSClient) BucketFS(ctx context.Context, bucket string) *BucketFS {
+	return &BucketFS{
+		ctx:    ctx,
+		client: client,
+		bucket: bucket,
+	}
+}
+
+// Open opens the named file or directory.
+// Files are read lazily with ranged GetObject requests.
+func (fsys *BucketFS) Open(name string) (fs.File, error) {
+	info, err := fsys.stat("open", name)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return &bucketDir{fsys: fsys, name: name, info: info}, nil
+	}
+	return &bucketFile{fsys: fsys, key: name, info: info}, nil
+}
+
+// Stat returns a FileInfo describing the named file or directory.
+func (fsys *BucketFS) Stat(name string) (fs.FileInfo, error) {
+	return fsys.stat("stat", name)
+}
+
+// ReadDir reads the named directory and returns its entries sorted by name.
+func (fsys *BucketFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+
+	entries, err := fsys.readDir(name)
+	if err != nil {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: err}
+	}
+	if len(entries) == 0 && name != "." {
+		// An empty listing means the prefix does not exist, unless it names an object
+		if info, err := fsys.stat("readdir", name); err != nil {
+			return nil, err
+		} else if !info.IsDir() {
+			return nil, &fs.PathError{Op: "readdir", Path: name, Err: errors.New("not a directory")}
+		}
+	}
+
+	return entries, nil
+}
+
+// stat resolves name to an object or an emulated directory.
+func (fsys *BucketFS) stat(op, name string) (*bucketFileInfo, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	if name == "." {
+		return &bucketFileInfo{name: ".", isDir: true}, nil
+	}
+
+	// Prefer an object with this exact key
+	head, err := fsys.client.HeadObject(fsys.ctx, fsys.bucket, name)
+	if err == nil {
+		return &bucketFileInfo{
+			name:    path.Base(name),
+			size:    head.ContentLength,
+			modTime: head.LastModified,
+		}, nil
+	}
+	if status.Code(err) != codes.NotFound {
+		return nil, &fs.PathError{Op: op, Path: name, Err: err}
+	}
+
+	// Fall back to treating the name as a prefix
+	objects, err := fsys.client.ListObjectSummaries(fsys.ctx, fsys.bucket, &ListObjectsOptions{
+		Prefix:  name + "/",
+		MaxKeys: 1,
+	})
+	if err != nil {
+		return nil, &fs.PathError{Op: op, Path: name, Err: err}
+	}
+	if len(objects) > 0 {
+		return &bucketFileInfo{name: path.Base(name), isDir: true}, nil
+	}
+
+	return nil, &fs.PathError{Op: op, Path: name, Err: fs.ErrNotExist}
+}
+
+// readDir lists the immediate children of the directory name.
+// Keys are listed a page at a time, and each subdirectory is skipped once it is seen, so the listing
+// does not walk the whole subtree.
+func (fsys *BucketFS) readDir(name string) ([]fs.DirEntry, error) {
+	prefix := ""
+	if name != "." {
+		prefix = name + "/"
+	}
+
+	seen := make(map[string]bool)
+	var entries []fs.DirEntry
+	startAfter := ""
+	for {
+		objects, err := fsys.client.ListObjectSummaries(fsys.ctx, fsys.bucket, &ListObjectsOptions{
+			Prefix:     prefix,
+			StartAfter: startAfter,
+			MaxKeys:    listPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range objects {
+			rest := strings.TrimPrefix(obj.Key, prefix)
+			child, _, isDir := strings.Cut(rest, "/")
+			if isDir {
+				// Continue after every key under the subdirectory
+				startAfter = max(startAfter, prefix+child+"/"+afterPrefix)
+			} else {
+				startAfter = max(startAfter, obj.Key)
+			}
+			// Skip keys that cannot be represented as valid fs paths
+			if child == "" || child == "." || child == ".." || seen[child] {
+				continue
+			}
+			seen[child] = true
+
+			info := &bucketFileInfo{name: child, isDir: isDir}
+			if !isDir {
+				info.size = obj.Size
+				info.modTime = summaryModTime(obj)
+			}
+			entries = append(entries, fs.FileInfoToDirEntry(info))
+		}
+		if len(objects) < listPageSize {
+			break
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+
+	return entries, nil
+}
+
+// summaryModTime returns the modification time of a listed object, if present.
+func summaryModTime(obj *pb.ObjectSummary) time.Time {
+	if obj.LastModified == nil {
+		return time.Time{}
+	}
+	return obj.LastModified.AsTime()
+}
+
+// bucketFileInfo implements fs.FileInfo for objects and emulated directories.
+type bucketFileInfo struct {
+	name    string
+	size    int64
+	modTime time.Time
+	isDir   bool
+}
+
+func (info *bucketFileInfo) Name() string       { return info.name }
+func (info *bucketFileInfo) Size() int64        { return info.size }
+func (info *bucketFileInfo) ModTime() time.Time { return info.modTime }
+func (info *bucketFileInfo) IsDir() bool        { return info.isDir }
+func (info *bucketFileInfo) Sys() any           { return nil }
+
+func (info *bucketFileInfo) Mode() fs.FileMode {
+	if info.isDir {
+		return fs.ModeDir | 0555
+	}
+	return 0444
+}
+
+// bucketFile is an open object. It implements fs.File, io.Seeker and io.ReaderAt.
+type bucketFile struct {
+	fsys   *BucketFS
+	key    string
+	info   *bucketFileInfo
+	offset int64
+	closed bool
+
+	// block caches the most recently fetched range to serve sequential reads
+	block      []byte
+	blockStart int64
+}
+
+// Ensure bucketFile implements the optional file interfaces
+var (
+	_ io.Seeker   = (*bucketFile)(nil)
+	_ io.ReaderAt = (*bucketFile)(nil)
+)
+
+func (f *bucketFile) Stat() (fs.FileInfo, error) {
+	if f.closed {
+		return nil, &fs.PathError{Op: "stat", Path: f.key, Err: fs.ErrClosed}
+	}
+	return f.info, nil
+}
+
+func (f *bucketFile) Close() error {
+	if f.closed {
+		return &fs.PathError{Op: "close", Path: f.key, Err: fs.ErrClosed}
+	}
+	f.closed = true
+	f.block = nil
+	return nil
+}
+
+// Read reads from the current offset, fetching the object one block at a time.
+func (f *bucketFile) Read(p []byte) (int, error) {
+	if f.closed {
+		return 0, &fs.PathError{Op: "read", Path: f.key, Err: fs.ErrClosed}
+	}
+	if f.offset >= f.info.size {
+		return 0, io.EOF
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	// Refill the block if the offset falls outside of it
+	if f.offset < f.blockStart || f.offset >= f.blockStart+int64(len(f.block)) {
+		block, err := f.fetch(f.offset, fsReadBlockSize)
+		if err != nil {
+			return 0, &fs.PathError{Op: "read", Path: f.key, Err: err}
+		}
+		f.block = block
+		f.blockStart = f.offset
+	}
+
+	n := copy(p, f.block[f.offset-f.blockStart:])
+	f.offset += int64(n)
+	return n, nil
+}
+
+// ReadAt reads len(p) bytes starting at off with a single ranged request.
+func (f *bucketFile) ReadAt(p []byte, off int64) (int, error) {
+	if f.closed {
+		return 0, &fs.PathError{Op: "read", Path: f.key, Err: fs.ErrClosed}
+	}
+	if off < 0 {
+		return 0, &fs.PathError{Op: "read", Path: f.key, Err: fs.ErrInvalid}
+	}
+	if off >= f.info.size {
+		return 0, io.EOF
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	data, err := f.fetch(off, int64(len(p)))
+	if err != nil {
+		return 0, &fs.PathError{Op: "read", Path: f.key, Err: err}
+	}
+
+	n := copy(p, data)
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (f *bucketFile) Seek(offset int64, whence int) (int64, error) {
+	if f.closed {
+		return 0, &fs.PathError{Op: "seek", Path: f.key, Err: fs.ErrClosed}
+	}
+
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		offset += f.info.size
+	default:
+		return 0, &fs.PathError{Op: "seek", Path: f.key, Err: fs.ErrInvalid}
+	}
+	if offset < 0 {
+		return 0, &fs.PathError{Op: "seek", Path: f.key, Err: fs.ErrInvalid}
+	}
+
+	f.offset = offset
+	return offset, nil
+}
+
+// fetch downloads up to length bytes starting at off, clamped to the object size.
+// It returns io.ErrUnexpectedEOF if the object returns fewer bytes, e.g. because it shrank since it was opened.
+func (f *bucketFile) fetch(off, length int64) ([]byte, error) {
+	end := off + length - 1
+	if end >= f.info.size {
+		end = f.info.size - 1
+	}
+
+	data, err := f.fsys.client.GetObject(f.fsys.ctx, f.fsys.bucket, f.key,
+		WithRange(fmt.Sprintf("bytes=%d-%d", off, end)))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) < end-off+1 {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return data, nil
+}
+
+// bucketDir is an open emulated directory. It implements fs.ReadDirFile.
+type bucketDir struct {
+	fsys    *BucketFS
+	name    string
+	info    *bucketFileInfo
+	entries []fs.DirEntry
+	loaded  bool
+	offset  int
+	closed  bool
+}
+
+func (d *bucketDir) Stat() (fs.FileInfo, error) {
+	if d.closed {
+		return nil, &fs.PathError{Op: "stat", Path: d.name, Err: fs.ErrClosed}
+	}
+	return d.info, nil
+}
+
+func (d *bucketDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.name, Err: errors.New("is a directory")}
+}
+
+func (d *bucketDir) Close() error {
+	if d.closed {
+		return &fs.PathError{Op: "close", Path: d.name, Err: fs.ErrClosed}
+	}
+	d.closed = true
+	return nil
+}
+
+// ReadDir returns the next n entries, or all remaining entries if n <= 0.
+func (d *bucketDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if d.closed {
+		return nil, &fs.PathError{Op: "readdir", Path: d.name, Err: fs.ErrClosed}
+	}
+	if !d.loaded {
+		entries, err := d.fsys.readDir(d.name)
+		if err != nil {
+			return nil, &fs.PathError{Op: "readdir", Path: d.name, Err: err}
+		}
+		d.entries = entries
+		d.loaded = true
+	}
+
+	remaining := d.entries[d.offset:]
+	if n <= 0 {
+		d.offset = len(d.entries)
+		return remaining, nil
+	}
+	if len(remaining) == 0 {
+		return nil, io.EOF
+	}
+	if n > len(remaining) {
+		n = len(remaining)
+	}
+	d.offset += n
+	return remaining[:n], nil
+}
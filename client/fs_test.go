@@ -0,0 +1,106 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBucketFS(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("hello.txt", []byte("hello, world\n"))
+	storage.put("empty", nil)
+	storage.put("docs/readme.md", []byte("# Readme\n"))
+	storage.put("docs/guide/intro.md", testData(3*1024, 1))
+	storage.put("docs/guide/usage.md", []byte("usage"))
+	storage.put("images/logo.png", testData(10, 2))
+
+	fsys := newTestClient(t, storage).BucketFS(context.Background(), "bucket")
+	err := fstest.TestFS(fsys,
+		"hello.txt", "empty", "docs/readme.md", "docs/guide/intro.md", "docs/guide/usage.md", "images/logo.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// unavailableHeads fails every HeadObject as if the service were down.
+type unavailableHeads struct {
+	*fakeStorage
+}
+
+func (s unavailableHeads) HeadObject(ctx context.Context, req *pb.HeadObjectRequest) (*pb.HeadObjectResponse, error) {
+	return nil, status.Error(codes.Unavailable, "service unavailable")
+}
+
+func TestBucketFSStatReportsServiceErrors(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("docs/readme.md", []byte("# Readme\n"))
+
+	client := newTestClient(t, unavailableHeads{storage})
+	client.retry.MaxAttempts = 1
+	fsys := client.BucketFS(context.Background(), "bucket")
+
+	// A failing HeadObject must not be mistaken for a missing object and answered from a listing
+	_, err := fsys.Stat("docs")
+	if err == nil || errors.Is(err, fs.ErrNotExist) || status.Code(err) != codes.Unavailable {
+		t.Errorf("Stat() error = %v, want the Unavailable error", err)
+	}
+	if got := storage.called("ListObjects"); got != 0 {
+		t.Errorf("ListObjects calls = %d, want 0", got)
+	}
+}
+
+func TestBucketFSReadShortObject(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("object", testData(100, 1))
+	fsys := newTestClient(t, storage).BucketFS(context.Background(), "bucket")
+
+	f, err := fsys.Open("object")
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer f.Close()
+
+	// The object shrinks after it was opened, so ranged reads return fewer bytes than its size
+	storage.put("object", testData(10, 1))
+	if _, err := io.ReadAll(f); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("ReadAll() error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+func TestBucketFSReadDirSkipsSubdirectories(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("dir/a", nil)
+	for i := 0; i < 2*listPageSize+500; i++ {
+		storage.put(fmt.Sprintf("dir/sub/%05d", i), nil)
+	}
+	storage.put("dir/z", nil)
+
+	fsys := newTestClient(t, storage).BucketFS(context.Background(), "bucket")
+	entries, err := fsys.ReadDir("dir")
+	if err != nil {
+		t.Fatalf("ReadDir() error = %v", err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	if want := []string{"a", "sub", "z"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("ReadDir() = %v, want %v", names, want)
+	}
+
+	// The first page ends inside dir/sub/, and the second continues past it
+	if got := storage.called("ListObjects"); got != 2 {
+		t.Errorf("ListObjects calls = %d, want 2", got)
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"path"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+)
+
+// Glob lists the objects of a bucket whose keys match pattern, yielding them as each page of the listing arrives.
+// In the pattern, '*' matches any sequence of characters other than '/' and '?' matches any single character
+// other than '/'. "**" matches any sequence of characters including '/', and a whole "**/" segment also matches
+// no directories at all. Character classes such as [abc] and [a-z] match one character of the class, and are
+// negated by [!abc] or [^abc]. A backslash matches the following character literally.
+// Only keys starting with the pattern's literal prefix are requested from the service.
+// An invalid pattern yields an error wrapping path.ErrBadPattern.
+func (client *ACSClient) Glob(ctx context.Context, bucket, pattern string) iter.Seq2[*pb.ObjectSummary, error] {
+	expr, err := globToRegexp(pattern)
+	if err != nil {
+		return func(yield func(*pb.ObjectSummary, error) bool) {
+			yield(nil, err)
+		}
+	}
+	re := regexp.MustCompile(expr)
+	return client.listMatching(ctx, bucket, globPrefix(pattern), re.MatchString)
+}
+
+// ListObjectsRegexp lists the objects of a bucket whose keys match re, yielding them as each page of the listing arrives.
+// Like regexp.MatchString, re matches anywhere in the key unless anchored. When re starts with ^ followed by
+// literal text, only keys starting with that text are requested from the service.
+func (client *ACSClient) ListObjectsRegexp(ctx context.Context, bucket string, re *regexp.Regexp) iter.Seq2[*pb.ObjectSummary, error] {
+	return client.listMatching(ctx, bucket, regexpPrefix(re), re.MatchString)
+}
+
+// listMatching lists the objects under prefix and yields those whose keys satisfy match.
+// The caller's loop body runs between page requests, so it may issue requests of its own.
+func (client *ACSClient) listMatching(ctx context.Context, bucket, prefix string, match func(string) bool) iter.Seq2[*pb.ObjectSummary, error] {
+	return func(yield func(*pb.ObjectSummary, error) bool) {
+		stopped := false
+		err := client.listObjectSummaries(ctx, bucket, &ListObjectsOptions{Prefix: prefix}, func(obj *pb.ObjectSummary) bool {
+			if !match(obj.Key) {
+				return true
+			}
+			stopped = !yield(obj, nil)
+			return !stopped
+		})
+		if err != nil && !stopped {
+			yield(nil, err)
+		}
+	}
+}
+
+// globPrefix returns the literal text before the first wildcard of a glob pattern.
+func globPrefix(pattern string) string {
+	var prefix strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '*', '?', '[':
+			return prefix.String()
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+			}
+		}
+		prefix.WriteByte(pattern[i])
+	}
+	return prefix.String()
+}
+
+// globToRegexp translates a glob pattern to an equivalent regular expression matching whole keys.
+func globToRegexp(pattern string) (string, error) {
+	badPattern := func() (string, error) {
+		return "", fmt.Errorf("invalid glob pattern %q: %w", pattern, path.ErrBadPattern)
+	}
+
+	var expr strings.Builder
+	expr.WriteString(`(?s)^`)
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; c {
+		case '*':
+			if i+1 < len(runes) && runes[i+1] == '*' {
+				start := i
+				for i+1 < len(runes) && runes[i+1] == '*' {
+					i++
+				}
+				// A whole "**/" path segment may also match nothing
+				if (start == 0 || runes[start-1] == '/') && i+1 < len(runes) && runes[i+1] == '/' {
+					i++
+					expr.WriteString(`(?:.*/)?`)
+				} else {
+					expr.WriteString(`.*`)
+				}
+			} else {
+				expr.WriteString(`[^/]*`)
+			}
+		case '?':
+			expr.WriteString(`[^/]`)
+		case '[':
+			class, next, ok := globClass(runes, i+1)
+			if !ok {
+				return badPattern()
+			}
+			expr.WriteString(class)
+			i = next
+		case '\\':
+			if i+1 == len(runes) {
+				return badPattern()
+			}
+			i++
+			expr.WriteString(regexp.QuoteMeta(string(runes[i])))
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	expr.WriteString(`$`)
+	return expr.String(), nil
+}
+
+// globClass translates the character class starting at runes[start], just after its '['.
+// It returns the regular expression class, the index of the closing ']', and whether the class is well formed.
+func globClass(runes []rune, start int) (string, int, bool) {
+	var class strings.Builder
+	class.WriteByte('[')
+	i := start
+	if i < len(runes) && (runes[i] == '!' || runes[i] == '^') {
+		// Like '*' and '?', a negated class never matches '/'
+		class.WriteString(`^/`)
+		i++
+	}
+
+	// literal reads one possibly escaped character of the class
+	literal := func() (rune, bool) {
+		if runes[i] == '\\' {
+			i++
+			if i == len(runes) {
+				return 0, false
+			}
+		}
+		c := runes[i]
+		i++
+		return c, true
+	}
+
+	// A ']' first in the class is literal
+	for first := true; i < len(runes); first = false {
+		if runes[i] == ']' && !first {
+			class.WriteByte(']')
+			return class.String(), i, true
+		}
+
+		lo, ok := literal()
+		if !ok {
+			return "", 0, false
+		}
+		class.WriteString(classLiteral(lo))
+		if i+1 < len(runes) && runes[i] == '-' && runes[i+1] != ']' {
+			i++
+			hi, ok := literal()
+			if !ok || hi < lo {
+				return "", 0, false
+			}
+			class.WriteByte('-')
+			class.WriteString(classLiteral(hi))
+		}
+	}
+	return "", 0, false
+}
+
+// classLiteral escapes c for use inside a regular expression character class.
+func classLiteral(c rune) string {
+	switch c {
+	case '\\', ']', '[', '^', '-':
+		return `\` + string(c)
+	default:
+		return string(c)
+	}
+}
+
+// regexpPrefix returns the literal text every match of re must start with, or "" if re is not anchored at the
+// start of the key by a leading ^ followed by literal text.
+func regexpPrefix(re *regexp.Regexp) string {
+	parsed, err := syntax.Parse(re.String(), syntax.Perl)
+	if err != nil {
+		return ""
+	}
+	parsed = parsed.Simplify()
+	if parsed.Op != syntax.OpConcat || len(parsed.Sub) == 0 || parsed.Sub[0].Op != syntax.OpBeginText {
+		return ""
+	}
+
+	var prefix strings.Builder
+	for _, sub := range parsed.Sub[1:] {
+		if sub.Op != syntax.OpLiteral || sub.Flags&syntax.FoldCase != 0 {
+			break
+		}
+		prefix.WriteString(string(sub.Rune))
+	}
+	return prefix.String()
+}
@@ -0,0 +1,34 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestGlobLoopBodyCanIssueRequests(t *testing.T) {
+	storage := newFakeStorage()
+	for _, key := range []string{"logs/a.txt", "logs/b.txt", "logs/c.csv", "other/d.txt"} {
+		storage.put(key, []byte(key))
+	}
+	client := newTestClient(t, storage)
+	// With a single concurrency slot, a loop body run inside the listing's attempt would wait forever
+	client.retry.limiter = newAdaptiveLimiter(&AdaptiveConcurrencyConfig{InitialLimit: 1, MaxLimit: 1})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var keys []string
+	for obj, err := range client.Glob(ctx, "bucket", "logs/*.txt") {
+		if err != nil {
+			t.Fatalf("Glob() error = %v", err)
+		}
+		if _, err := client.HeadObject(ctx, "bucket", obj.Key); err != nil {
+			t.Fatalf("HeadObject(%q) inside the loop error = %v", obj.Key, err)
+		}
+		keys = append(keys, obj.Key)
+	}
+	if len(keys) != 2 || keys[0] != "logs/a.txt" || keys[1] != "logs/b.txt" {
+		t.Errorf("Glob() = %v, want [logs/a.txt logs/b.txt]", keys)
+	}
+}
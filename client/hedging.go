@@ -0,0 +1,201 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Hedging constants
+const (
+	defaultHedgeDelay    = 100 * time.Millisecond // Delay before hedging when no percentile is available
+	defaultMaxHedgeRatio = 0.1                    // At most 10% of requests are hedged
+	hedgeLatencySamples  = 256                    // Recent first-byte latencies kept for percentiles
+	minHedgeSamples      = 20                     // Samples needed before the percentile is used
+	maxHedgeTokens       = 10                     // Largest burst of hedges allowed by the budget
+)
+
+// HedgingConfig enables hedged GetObject and HeadObject requests.
+// If the first byte of a response has not arrived after the hedge delay, a second identical request is sent;
+// whichever starts answering first is used and the other is cancelled.
+type HedgingConfig struct {
+	// Delay is how long to wait for the first byte before hedging (default 100ms).
+	// When Percentile is set, it is used until enough latencies have been observed.
+	Delay time.Duration
+	// Percentile, if set (for example 0.95), derives the delay from that percentile of recent first-byte latencies
+	Percentile float64
+	// MaxHedgeRatio limits hedged requests to this fraction of all requests (default 0.1)
+	MaxHedgeRatio float64
+}
+
+// hedger decides when to hedge and keeps the statistics hedging decisions are based on.
+type hedger struct {
+	config HedgingConfig
+
+	mu        sync.Mutex
+	latencies []time.Duration // Ring buffer of first-byte latencies
+	next      int
+	tokens    float64 // Hedging budget; each request adds MaxHedgeRatio, each hedge costs one
+}
+
+// newHedger returns a hedger for config, or nil if config is nil.
+func newHedger(config *HedgingConfig) *hedger {
+	if config == nil {
+		return nil
+	}
+	h := &hedger{config: *config}
+	if h.config.Delay <= 0 {
+		h.config.Delay = defaultHedgeDelay
+	}
+	if h.config.MaxHedgeRatio <= 0 {
+		h.config.MaxHedgeRatio = defaultMaxHedgeRatio
+	}
+	return h
+}
+
+// delay returns how long to wait for the first byte before hedging, and adds the request to the budget.
+func (h *hedger) delay() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.tokens = min(h.tokens+h.config.MaxHedgeRatio, maxHedgeTokens)
+
+	if h.config.Percentile <= 0 || len(h.latencies) < minHedgeSamples {
+		return h.config.Delay
+	}
+	sorted := make([]time.Duration, len(h.latencies))
+	copy(sorted, h.latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	index := int(math.Ceil(h.config.Percentile*float64(len(sorted)))) - 1
+	return sorted[min(max(index, 0), len(sorted)-1)]
+}
+
+// allow reports whether the budget permits another hedge, spending it if so.
+func (h *hedger) allow() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.tokens < 1 {
+		return false
+	}
+	h.tokens--
+	return true
+}
+
+// refund returns the budget spent on a hedge that was not sent.
+func (h *hedger) refund() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.tokens = min(h.tokens+1, maxHedgeTokens)
+}
+
+// observe records the first-byte latency of a request.
+func (h *hedger) observe(latency time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.latencies) < hedgeLatencySamples {
+		h.latencies = append(h.latencies, latency)
+		return
+	}
+	h.latencies[h.next] = latency
+	h.next = (h.next + 1) % hedgeLatencySamples
+}
+
+// hedgeResult is the outcome of one hedged attempt.
+type hedgeResult[T any] struct {
+	index int32
+	value T
+	err   error
+}
+
+// hedge runs attempt, starting a second copy of it if the first has not received its first byte in time.
+// Each copy calls firstByte when its response starts arriving; the first caller wins and the other copy
+// is cancelled. A copy for which firstByte returns false has lost and should stop.
+// The second copy takes a slot of its own from limiter and is not sent if none is free, so hedging never
+// exceeds the adaptive concurrency limit. With a nil hedger, attempt runs once.
+func hedge[T any](ctx context.Context, h *hedger, limiter *adaptiveLimiter, attempt func(ctx context.Context, firstByte func() bool) (T, error)) (T, error) {
+	if h == nil {
+		return attempt(ctx, func() bool { return true })
+	}
+
+	results := make(chan hedgeResult[T], 2)
+	var mu sync.Mutex
+	var cancels []context.CancelFunc
+	defer func() {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}()
+
+	var winner atomic.Int32 // 0 until a copy wins, then its index plus one
+	launch := func(index int32, release func(error)) {
+		start := time.Now()
+		attemptCtx, cancel := context.WithCancel(ctx)
+		mu.Lock()
+		cancels = append(cancels, cancel)
+		mu.Unlock()
+
+		firstByte := func() bool {
+			if !winner.CompareAndSwap(0, index+1) {
+				return winner.Load() == index+1
+			}
+			h.observe(time.Since(start))
+
+			// Cancel the other copy
+			mu.Lock()
+			defer mu.Unlock()
+			for i, cancel := range cancels {
+				if int32(i) != index {
+					cancel()
+				}
+			}
+			return true
+		}
+		go func() {
+			value, err := attempt(attemptCtx, firstByte)
+			if release != nil {
+				release(err)
+			}
+			results <- hedgeResult[T]{index: index, value: value, err: err}
+		}()
+	}
+
+	launch(0, nil) // The first copy runs in the slot its caller acquired
+	timer := time.NewTimer(h.delay())
+	defer timer.Stop()
+
+	pending := 1
+	for {
+		select {
+		case <-timer.C:
+			if winner.Load() == 0 && h.allow() {
+				started, ok := limiter.tryAcquire()
+				if !ok {
+					h.refund()
+					break
+				}
+				launch(1, func(err error) { limiter.release(started, err) })
+				pending++
+			}
+		case result := <-results:
+			pending--
+			won := winner.Load()
+			switch {
+			case result.err == nil, won == result.index+1:
+				return result.value, result.err
+			case won != 0:
+				// The cancelled loser
+			case pending == 0:
+				return result.value, result.err
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// slowAttempt waits for the first byte of a request that only answers after delay.
+func slowAttempt(calls *atomic.Int32, delay time.Duration) func(ctx context.Context, firstByte func() bool) (int, error) {
+	return func(ctx context.Context, firstByte func() bool) (int, error) {
+		calls.Add(1)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+		if !firstByte() {
+			return 0, context.Canceled
+		}
+		return 1, nil
+	}
+}
+
+func TestHedgeTakesItsOwnLimiterSlot(t *testing.T) {
+	h := newHedger(&HedgingConfig{Delay: time.Millisecond, MaxHedgeRatio: 1})
+	h.tokens = maxHedgeTokens
+	limiter := newAdaptiveLimiter(&AdaptiveConcurrencyConfig{InitialLimit: 2, MaxLimit: 2})
+
+	// The caller holds one slot for the first copy, leaving one for the hedge
+	started, _ := limiter.acquire(context.Background())
+	var calls atomic.Int32
+	if _, err := hedge(context.Background(), h, limiter, slowAttempt(&calls, 20*time.Millisecond)); err != nil {
+		t.Fatalf("hedge() error = %v", err)
+	}
+	limiter.release(started, nil)
+	if got := calls.Load(); got != 2 {
+		t.Errorf("attempts = %d, want 2 (hedged)", got)
+	}
+
+	// Wait for the losing copy to give its slot back
+	deadline := time.Now().Add(time.Second)
+	for _, inFlight := limiter.state(); inFlight != 0; _, inFlight = limiter.state() {
+		if time.Now().After(deadline) {
+			t.Fatalf("limiter has %d requests in flight after hedging, want 0", inFlight)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestHedgeSkippedWithoutLimiterSlot(t *testing.T) {
+	h := newHedger(&HedgingConfig{Delay: time.Millisecond, MaxHedgeRatio: 1})
+	h.tokens = maxHedgeTokens
+	limiter := newAdaptiveLimiter(&AdaptiveConcurrencyConfig{InitialLimit: 1, MaxLimit: 1})
+
+	// The first copy holds the only slot, so there is none for a hedge
+	started, _ := limiter.acquire(context.Background())
+	var calls atomic.Int32
+	if _, err := hedge(context.Background(), h, limiter, slowAttempt(&calls, 20*time.Millisecond)); err != nil {
+		t.Fatalf("hedge() error = %v", err)
+	}
+	limiter.release(started, nil)
+	if got := calls.Load(); got != 1 {
+		t.Errorf("attempts = %d, want 1 (not hedged)", got)
+	}
+	if h.tokens < maxHedgeTokens-1 {
+		t.Errorf("hedging budget = %v, want the skipped hedges refunded", h.tokens)
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+)
+
+// Lifecycle limits
+const (
+	maxLifecycleRules        = 1000 // Maximum number of rules in a lifecycle configuration
+	maxLifecycleRuleIDLength = 255  // Maximum length of a rule ID
+)
+
+// LifecycleRule describes one rule of a bucket lifecycle configuration.
+// A rule applies its actions to every object matching its filter.
+type LifecycleRule struct {
+	// ID uniquely identifies the rule within the configuration
+	ID string
+	// Enabled controls whether the rule is applied
+	Enabled bool
+	// Filter selects the objects the rule applies to
+	Filter LifecycleFilter
+	// ExpirationDays expires current object versions this many days after creation (0 disables)
+	ExpirationDays int32
+	// NoncurrentVersionExpirationDays removes versions this many days after they become noncurrent (0 disables)
+	NoncurrentVersionExpirationDays int32
+	// AbortIncompleteMultipartUploadDays aborts multipart uploads this many days after initiation (0 disables)
+	AbortIncompleteMultipartUploadDays int32
+}
+
+// LifecycleFilter selects objects by key prefix and tags.
+// An empty filter matches every object in the bucket.
+type LifecycleFilter struct {
+	// Prefix matches objects whose key starts with this value
+	Prefix string
+	// Tags matches objects carrying all of these tags
+	Tags map[string]string
+}
+
+// validateLifecycleRules checks a lifecycle configuration before it is sent to the service.
+func validateLifecycleRules(rules []LifecycleRule) error {
+	if len(rules) == 0 {
+		return fmt.Errorf("lifecycle configuration must contain at least one rule")
+	}
+	if len(rules) > maxLifecycleRules {
+		return fmt.Errorf("lifecycle configuration has %d rules, maximum is %d", len(rules), maxLifecycleRules)
+	}
+
+	ids := make(map[string]bool, len(rules))
+	for i, rule := range rules {
+		if rule.ID == "" {
+			return fmt.Errorf("lifecycle rule %d: ID must not be empty", i)
+		}
+		if len(rule.ID) > maxLifecycleRuleIDLength {
+			return fmt.Errorf("lifecycle rule %q: ID exceeds %d characters", rule.ID, maxLifecycleRuleIDLength)
+		}
+		if ids[rule.ID] {
+			return fmt.Errorf("lifecycle rule %q: duplicate ID", rule.ID)
+		}
+		ids[rule.ID] = true
+
+		if rule.ExpirationDays < 0 || rule.NoncurrentVersionExpirationDays < 0 || rule.AbortIncompleteMultipartUploadDays < 0 {
+			return fmt.Errorf("lifecycle rule %q: days must be positive", rule.ID)
+		}
+		if rule.ExpirationDays == 0 && rule.NoncurrentVersionExpirationDays == 0 && rule.AbortIncompleteMultipartUploadDays == 0 {
+			return fmt.Errorf("lifecycle rule %q: at least one action must be specified", rule.ID)
+		}
+		if rule.AbortIncompleteMultipartUploadDays > 0 && len(rule.Filter.Tags) > 0 {
+			// Multipart uploads have no tags until they complete
+			return fmt.Errorf("lifecycle rule %q: aborting incomplete multipart uploads cannot be combined with a tag filter", rule.ID)
+		}
+		for key, value := range rule.Filter.Tags {
+			if err := validateTag(key, value); err != nil {
+				return fmt.Errorf("lifecycle rule %q: %v", rule.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// toProto converts a lifecycle rule to its wire representation.
+func (rule LifecycleRule) toProto() *pb.LifecycleRule {
+	out := &pb.LifecycleRule{
+		Id:      rule.ID,
+		Enabled: rule.Enabled,
+		Filter: &pb.LifecycleFilter{
+			Prefix: rule.Filter.Prefix,
+			Tags:   rule.Filter.Tags,
+		},
+	}
+	if rule.ExpirationDays > 0 {
+		out.ExpirationDays = &rule.ExpirationDays
+	}
+	if rule.NoncurrentVersionExpirationDays > 0 {
+		out.NoncurrentVersionExpirationDays = &rule.NoncurrentVersionExpirationDays
+	}
+	if rule.AbortIncompleteMultipartUploadDays > 0 {
+		out.AbortIncompleteMultipartUploadDays = &rule.AbortIncompleteMultipartUploadDays
+	}
+	return out
+}
+
+// lifecycleRuleFromProto converts a lifecycle rule from its wire representation.
+func lifecycleRuleFromProto(rule *pb.LifecycleRule) LifecycleRule {
+	return LifecycleRule{
+		ID:      rule.Id,
+		Enabled: rule.Enabled,
+		Filter: LifecycleFilter{
+			Prefix: rule.GetFilter().GetPrefix(),
+			Tags:   rule.GetFilter().GetTags(),
+		},
+		ExpirationDays:                     rule.GetExpirationDays(),
+		NoncurrentVersionExpirationDays:    rule.GetNoncurrentVersionExpirationDays(),
+		AbortIncompleteMultipartUploadDays: rule.GetAbortIncompleteMultipartUploadDays(),
+	}
+}
+
+// PutBucketLifecycle replaces the lifecycle configuration of a bucket.
+// The rules are validated locally before they are sent.
+// It returns an error if validation or the operation fails.
+func (client *ACSClient) PutBucketLifecycle(ctx context.Context, bucket string, rules []LifecycleRule) error {
+	if err := validateLifecycleRules(rules); err != nil {
+		return err
+	}
+
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		req := &pb.PutBucketLifecycleRequest{
+			Bucket: bucket,
+			Rules:  make([]*pb.LifecycleRule, len(rules)),
+		}
+		for i, rule := range rules {
+			req.Rules[i] = rule.toProto()
+		}
+
+		_, err := client.client.PutBucketLifecycle(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to put bucket lifecycle: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetBucketLifecycle retrieves the lifecycle configuration of a bucket.
+// It returns the configured rules and an error if the operation fails.
+func (client *ACSClient) GetBucketLifecycle(ctx context.Context, bucket string) ([]LifecycleRule, error) {
+	return withRetry(ctx, client.retry, func(ctx context.Context) ([]LifecycleRule, error) {
+		req := &pb.GetBucketLifecycleRequest{
+			Bucket: bucket,
+		}
+
+		resp, err := client.client.GetBucketLifecycle(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bucket lifecycle: %w", err)
+		}
+
+		rules := make([]LifecycleRule, len(resp.Rules))
+		for i, rule := range resp.Rules {
+			rules[i] = lifecycleRuleFromProto(rule)
+		}
+
+		return rules, nil
+	})
+}
+
+// DeleteBucketLifecycle removes the lifecycle configuration of a bucket.
+// It returns an error if the operation fails.
+func (client *ACSClient) DeleteBucketLifecycle(ctx context.Context, bucket string) error {
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		req := &pb.DeleteBucketLifecycleRequest{
+			Bucket: bucket,
+		}
+
+		_, err := client.client.DeleteBucketLifecycle(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to delete bucket lifecycle: %w", err)
+		}
+
+		return nil
+	})
+}
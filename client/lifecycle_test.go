@@ -0,0 +1,84 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateLifecycleRules(t *testing.T) {
+	expire := LifecycleRule{ID: "expire", ExpirationDays: 30}
+	tooMany := make([]LifecycleRule, maxLifecycleRules+1)
+	for i := range tooMany {
+		tooMany[i] = LifecycleRule{ID: strings.Repeat("r", i%maxLifecycleRuleIDLength+1), ExpirationDays: 1}
+	}
+
+	tests := []struct {
+		name    string
+		rules   []LifecycleRule
+		wantErr string
+	}{
+		{name: "valid", rules: []LifecycleRule{
+			expire,
+			{ID: "logs", Filter: LifecycleFilter{Prefix: "logs/", Tags: map[string]string{"tier": "cold"}}, NoncurrentVersionExpirationDays: 7},
+			{ID: "uploads", AbortIncompleteMultipartUploadDays: 1},
+		}},
+		{name: "empty", wantErr: "at least one rule"},
+		{name: "too many rules", rules: tooMany, wantErr: "maximum is 1000"},
+		{name: "missing ID", rules: []LifecycleRule{{ExpirationDays: 1}}, wantErr: "ID must not be empty"},
+		{name: "long ID", rules: []LifecycleRule{{ID: strings.Repeat("r", maxLifecycleRuleIDLength+1), ExpirationDays: 1}}, wantErr: "exceeds 255 characters"},
+		{name: "duplicate ID", rules: []LifecycleRule{expire, expire}, wantErr: "duplicate ID"},
+		{name: "negative days", rules: []LifecycleRule{{ID: "r", ExpirationDays: -1}}, wantErr: "days must be positive"},
+		{name: "no action", rules: []LifecycleRule{{ID: "r", Enabled: true}}, wantErr: "at least one action"},
+		{name: "abort with tag filter", rules: []LifecycleRule{{
+			ID: "r", Filter: LifecycleFilter{Tags: map[string]string{"k": "v"}}, AbortIncompleteMultipartUploadDays: 1,
+		}}, wantErr: "cannot be combined with a tag filter"},
+		{name: "invalid tag", rules: []LifecycleRule{{
+			ID: "r", Filter: LifecycleFilter{Tags: map[string]string{"": "v"}}, ExpirationDays: 1,
+		}}, wantErr: `lifecycle rule "r"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLifecycleRules(tt.rules)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validateLifecycleRules() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateLifecycleRules() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLifecycleRuleProtoRoundTrip(t *testing.T) {
+	rule := LifecycleRule{
+		ID:                              "logs",
+		Enabled:                         true,
+		Filter:                          LifecycleFilter{Prefix: "logs/", Tags: map[string]string{"tier": "cold"}},
+		ExpirationDays:                  30,
+		NoncurrentVersionExpirationDays: 7,
+	}
+
+	out := rule.toProto()
+	if out.AbortIncompleteMultipartUploadDays != nil {
+		t.Errorf("disabled action sent as %d days, want it omitted", *out.AbortIncompleteMultipartUploadDays)
+	}
+	if got := lifecycleRuleFromProto(out); !reflect.DeepEqual(got, rule) {
+		t.Errorf("round trip = %+v, want %+v", got, rule)
+	}
+}
+
+func TestPutBucketLifecycleValidatesLocally(t *testing.T) {
+	client := newTestClient(t, newFakeStorage())
+
+	// The fake does not implement lifecycle RPCs, so any request that reached it would fail as Unimplemented
+	err := client.PutBucketLifecycle(context.Background(), "bucket", []LifecycleRule{{ID: "r"}})
+	if err == nil || !strings.Contains(err.Error(), "at least one action") {
+		t.Errorf("PutBucketLifecycle() error = %v, want a validation error", err)
+	}
+}
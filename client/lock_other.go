@@ -0,0 +1,35 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+//go:build !unix
+
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"os"
+	"sync"
+)
+
+// fileLocks serializes lock holders within the process on platforms without flock.
+var fileLocks sync.Map
+
+// lockFile opens path and takes an exclusive lock on it.
+// Without flock the lock only excludes other goroutines in this process.
+func lockFile(path string) (*os.File, error) {
+	mu, _ := fileLocks.LoadOrStore(path, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		mu.(*sync.Mutex).Unlock()
+		return nil, err
+	}
+	return f, nil
+}
+
+// unlockFile releases a lock taken by lockFile.
+func unlockFile(f *os.File) error {
+	if mu, ok := fileLocks.Load(f.Name()); ok {
+		mu.(*sync.Mutex).Unlock()
+	}
+	return f.Close()
+}
@@ -0,0 +1,399 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Rename failure modes
+const (
+	// RenameContinue moves every object it can and reports the failures; rerunning with the same
+	// journal finishes the rename
+	RenameContinue = "continue"
+	// RenameRollback undoes the copies made so far when any object fails, so every object
+	// stays under the source prefix
+	RenameRollback = "rollback"
+)
+
+// Journal entry states
+const (
+	journalCopied     = "copied"
+	journalDeleted    = "deleted"
+	journalRolledBack = "rolledback"
+)
+
+// RenamePrefixOptions holds optional parameters for RenamePrefix.
+type RenamePrefixOptions struct {
+	// Concurrency is the number of objects copied in parallel (default 8)
+	Concurrency int
+	// JournalPath records completed steps so that an interrupted rename can be resumed.
+	// Objects already moved are skipped when the same journal is passed again.
+	JournalPath string
+	// OnFailure is RenameContinue (default) or RenameRollback
+	OnFailure string
+}
+
+// RenamePrefixResult reports the outcome of RenamePrefix.
+type RenamePrefixResult struct {
+	// Moved lists the source keys that now only exist under the destination prefix
+	Moved []string
+	// Failed maps source keys that could not be moved to the reason
+	Failed map[string]error
+	// RolledBack lists the destination keys removed while rolling back
+	RolledBack []string
+}
+
+// MoveObject moves an object by copying it, verifying the copy and then deleting the source.
+// The copy is conditioned on the source ETag and the source is only deleted once the destination
+// matches it, so a failure never loses data; at worst the object exists in both places.
+// It returns an error if any step fails.
+func (client *ACSClient) MoveObject(ctx context.Context, srcBucket, srcKey, dstBucket, dstKey string) error {
+	if srcBucket == dstBucket && srcKey == dstKey {
+		return fmt.Errorf("source and destination are the same object")
+	}
+
+	source, err := client.HeadObject(ctx, srcBucket, srcKey)
+	if err != nil {
+		return fmt.Errorf("failed to read move source: %w", err)
+	}
+
+	if _, err := client.copyVerified(ctx, srcBucket, srcKey, dstBucket, dstKey, source.ETag, source.ContentLength); err != nil {
+		return err
+	}
+
+	if err := client.DeleteObject(ctx, srcBucket, srcKey); err != nil {
+		return fmt.Errorf("copied to %s/%s but failed to delete source: %w", dstBucket, dstKey, err)
+	}
+	return nil
+}
+
+// copyVerified copies an object conditioned on its ETag and checks that the destination has the expected size.
+// It returns the ETag of the destination.
+func (client *ACSClient) copyVerified(ctx context.Context, srcBucket, srcKey, dstBucket, dstKey, etag string, size int64) (string, error) {
+	output, err := client.CopyObjectWithInput(ctx, CopyObjectInput{
+		SourceBucket:        srcBucket,
+		SourceKey:           srcKey,
+		Bucket:              dstBucket,
+		Key:                 dstKey,
+		IfSourceETagMatches: etag,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	dest, err := client.HeadObject(ctx, dstBucket, dstKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to verify copy: %w", err)
+	}
+	if dest.ContentLength != size {
+		return "", fmt.Errorf("copy of %s has %d bytes, expected %d", srcKey, dest.ContentLength, size)
+	}
+	if output.ETag != "" && dest.ETag != output.ETag {
+		return "", fmt.Errorf("copy of %s was modified concurrently", srcKey)
+	}
+	return dest.ETag, nil
+}
+
+// copyIntact reports whether the destination of a journaled copy still holds that copy.
+// A journal entry alone is not enough to delete a source: the copy may have been removed or overwritten since.
+func (client *ACSClient) copyIntact(ctx context.Context, bucket string, entry moveJournalEntry, size int64) bool {
+	dest, err := client.HeadObject(ctx, bucket, entry.Destination)
+	if err != nil {
+		return false
+	}
+	etag := entry.DestinationETag
+	if etag == "" {
+		etag = entry.ETag
+	}
+	return dest.ContentLength == size && dest.ETag == etag
+}
+
+// RenamePrefix moves every object under srcPrefix to the same relative key under dstPrefix.
+// Objects are copied concurrently with ETag verification, then the sources are deleted in bulk.
+// With a journal, progress survives interruptions and a rerun only performs the remaining steps.
+// It returns a result describing every object and an error if any object could not be moved.
+func (client *ACSClient) RenamePrefix(ctx context.Context, bucket, srcPrefix, dstPrefix string, opts *RenamePrefixOptions) (*RenamePrefixResult, error) {
+	options := RenamePrefixOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.Concurrency <= 0 {
+		options.Concurrency = defaultConcurrency
+	}
+	switch options.OnFailure {
+	case "":
+		options.OnFailure = RenameContinue
+	case RenameContinue, RenameRollback:
+	default:
+		return nil, fmt.Errorf("invalid failure mode %q: must be %q or %q", options.OnFailure, RenameContinue, RenameRollback)
+	}
+	if srcPrefix == dstPrefix {
+		return nil, fmt.Errorf("source and destination prefixes are the same")
+	}
+	if strings.HasPrefix(dstPrefix, srcPrefix) {
+		return nil, fmt.Errorf("destination prefix %q is inside source prefix %q", dstPrefix, srcPrefix)
+	}
+
+	journal, err := openMoveJournal(options.JournalPath)
+	if err != nil {
+		return nil, err
+	}
+	defer journal.close()
+
+	objects, err := client.ListObjectSummaries(ctx, bucket, &ListObjectsOptions{Prefix: srcPrefix})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RenamePrefixResult{Failed: make(map[string]error)}
+	var resultMu sync.Mutex
+	fail := func(key string, err error) {
+		resultMu.Lock()
+		result.Failed[key] = err
+		resultMu.Unlock()
+	}
+
+	// Phase 1: copy every object that has not been copied yet
+	var copied []string
+	work := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < options.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range work {
+				obj := objects[index]
+				dstKey := dstPrefix + strings.TrimPrefix(obj.Key, srcPrefix)
+
+				if entry, ok := journal.copied(obj.Key, obj.Etag); !ok || !client.copyIntact(ctx, bucket, entry, obj.Size) {
+					dstETag, err := client.copyVerified(ctx, bucket, obj.Key, bucket, dstKey, obj.Etag, obj.Size)
+					if err != nil {
+						fail(obj.Key, err)
+						continue
+					}
+					entry := moveJournalEntry{Source: obj.Key, Destination: dstKey, ETag: obj.Etag, DestinationETag: dstETag, State: journalCopied}
+					if err := journal.record(entry); err != nil {
+						fail(obj.Key, err)
+						continue
+					}
+				}
+
+				resultMu.Lock()
+				copied = append(copied, obj.Key)
+				resultMu.Unlock()
+			}
+		}()
+	}
+	for index := range objects {
+		if ctx.Err() != nil {
+			break
+		}
+		work <- index
+	}
+	close(work)
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return result, err
+	}
+
+	etags := make(map[string]string, len(objects))
+	for _, obj := range objects {
+		etags[obj.Key] = obj.Etag
+	}
+
+	if len(result.Failed) > 0 && options.OnFailure == RenameRollback {
+		client.rollbackCopies(ctx, bucket, srcPrefix, dstPrefix, copied, etags, journal, result)
+		if err := journal.remove(); err != nil {
+			fmt.Printf("Warning: Failed to remove rename journal: %v\n", err)
+		}
+		return result, fmt.Errorf("rolled back rename after %d of %d objects failed to copy", len(result.Failed), len(objects))
+	}
+
+	// Phase 2: delete the sources of verified copies
+	recordDeleted := func(key string) {
+		dstKey := dstPrefix + strings.TrimPrefix(key, srcPrefix)
+		if err := journal.record(moveJournalEntry{Source: key, Destination: dstKey, ETag: etags[key], State: journalDeleted}); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+		result.Moved = append(result.Moved, key)
+	}
+	for start := 0; start < len(copied); start += maxDeleteBatch {
+		batch := copied[start:min(start+maxDeleteBatch, len(copied))]
+		if err := client.DeleteObjects(ctx, bucket, batch); err == nil {
+			for _, key := range batch {
+				recordDeleted(key)
+			}
+			continue
+		}
+
+		// Fall back to individual deletes to find out which keys failed
+		for _, key := range batch {
+			if err := client.DeleteObject(ctx, bucket, key); err != nil {
+				// A delete whose response was lost may still have taken effect
+				if _, headErr := client.HeadObject(ctx, bucket, key); status.Code(headErr) == codes.NotFound {
+					recordDeleted(key)
+					continue
+				}
+				result.Failed[key] = fmt.Errorf("copied but failed to delete source: %w", err)
+				if options.OnFailure == RenameRollback {
+					client.rollbackCopies(ctx, bucket, srcPrefix, dstPrefix, []string{key}, etags, journal, result)
+				}
+				continue
+			}
+			recordDeleted(key)
+		}
+	}
+
+	if len(result.Failed) > 0 {
+		return result, fmt.Errorf("failed to move %d of %d objects", len(result.Failed), len(objects))
+	}
+	if err := journal.remove(); err != nil {
+		fmt.Printf("Warning: Failed to remove rename journal: %v\n", err)
+	}
+	return result, nil
+}
+
+// maxDeleteBatch is the number of keys deleted per DeleteObjects request.
+const maxDeleteBatch = 1000
+
+// rollbackCopies deletes the destination copies of the given source keys.
+// A copy is only deleted once its source is confirmed to still exist, and the journal marks it
+// rolled back first so that a rerun copies the object again instead of trusting the old entry.
+func (client *ACSClient) rollbackCopies(ctx context.Context, bucket, srcPrefix, dstPrefix string, sources []string, etags map[string]string, journal *moveJournal, result *RenamePrefixResult) {
+	for _, key := range sources {
+		dstKey := dstPrefix + strings.TrimPrefix(key, srcPrefix)
+		if _, err := client.HeadObject(ctx, bucket, key); err != nil {
+			result.Failed[key] = fmt.Errorf("kept copy %s because the source could not be confirmed: %w", dstKey, err)
+			continue
+		}
+		if err := journal.record(moveJournalEntry{Source: key, Destination: dstKey, ETag: etags[key], State: journalRolledBack}); err != nil {
+			result.Failed[key] = fmt.Errorf("failed to roll back copy %s: %w", dstKey, err)
+			continue
+		}
+		if err := client.DeleteObject(ctx, bucket, dstKey); err != nil {
+			result.Failed[key] = fmt.Errorf("failed to roll back copy %s: %w", dstKey, err)
+			continue
+		}
+		result.RolledBack = append(result.RolledBack, dstKey)
+	}
+}
+
+// moveJournalEntry is one line of the rename journal.
+type moveJournalEntry struct {
+	Source          string `json:"source"`
+	Destination     string `json:"destination"`
+	ETag            string `json:"etag,omitempty"`
+	DestinationETag string `json:"destination_etag,omitempty"`
+	State           string `json:"state"`
+}
+
+// moveJournal is an append-only log of completed rename steps, one JSON entry per line.
+// A nil file means journaling is disabled.
+type moveJournal struct {
+	mu      sync.Mutex
+	path    string
+	file    *os.File
+	entries map[string]moveJournalEntry
+}
+
+// openMoveJournal loads an existing journal and opens it for appending.
+func openMoveJournal(path string) (*moveJournal, error) {
+	journal := &moveJournal{path: path, entries: make(map[string]moveJournalEntry)}
+	if path == "" {
+		return journal, nil
+	}
+
+	if f, err := os.Open(path); err == nil {
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var entry moveJournalEntry
+			// A torn final line from a crash is ignored
+			if json.Unmarshal(scanner.Bytes(), &entry) != nil {
+				continue
+			}
+			journal.entries[entry.Source] = entry
+		}
+		f.Close()
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read rename journal: %v", err)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to open rename journal: %v", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open rename journal: %v", err)
+	}
+	journal.file = f
+	return journal, nil
+}
+
+// copied returns the journal entry of this version of the source (identified by its ETag)
+// and reports whether it has been copied. Rolled back copies do not count.
+func (journal *moveJournal) copied(source, etag string) (moveJournalEntry, bool) {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	entry, ok := journal.entries[source]
+	if !ok || entry.ETag != etag {
+		return moveJournalEntry{}, false
+	}
+	return entry, entry.State == journalCopied || entry.State == journalDeleted
+}
+
+// record appends an entry and syncs it to disk before returning.
+func (journal *moveJournal) record(entry moveJournalEntry) error {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+
+	journal.entries[entry.Source] = entry
+	if journal.file == nil {
+		return nil
+	}
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal journal entry: %v", err)
+	}
+	if _, err := journal.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write rename journal: %v", err)
+	}
+	if err := journal.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync rename journal: %v", err)
+	}
+	return nil
+}
+
+// close closes the journal file.
+func (journal *moveJournal) close() {
+	journal.mu.Lock()
+	defer journal.mu.Unlock()
+	if journal.file != nil {
+		journal.file.Close()
+		journal.file = nil
+	}
+}
+
+// remove deletes the journal once the rename has finished or been rolled back.
+func (journal *moveJournal) remove() error {
+	journal.close()
+	if journal.path == "" {
+		return nil
+	}
+	if err := os.Remove(journal.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
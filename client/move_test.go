@@ -0,0 +1,152 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// failDeletes makes DeleteObjects fail and DeleteObject of the given keys report Unavailable.
+// When applied is true the failing deletes still take effect, as if only the response was lost.
+func failDeletes(applied bool, keys ...string) grpc.ServerOption {
+	return grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		switch info.FullMethod {
+		case pb.ObjectStorageCache_DeleteObjects_FullMethodName:
+			return nil, status.Error(codes.Internal, "batch delete failed")
+		case pb.ObjectStorageCache_DeleteObject_FullMethodName:
+			for _, key := range keys {
+				if req.(*pb.DeleteObjectRequest).Key != key {
+					continue
+				}
+				if applied {
+					handler(ctx, req)
+				}
+				return nil, status.Error(codes.Unavailable, "connection reset")
+			}
+		}
+		return handler(ctx, req)
+	})
+}
+
+// readJournal returns the entries of a rename journal in the order they were written.
+func readJournal(t *testing.T, path string) []moveJournalEntry {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read journal: %v", err)
+	}
+	var entries []moveJournalEntry
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		var entry moveJournalEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("invalid journal line %q: %v", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestRenamePrefixRollbackKeepsCopyOfDeletedSource(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("src/a", testData(100, 1))
+	client := newTestClientWith(t, storage, []grpc.ServerOption{failDeletes(true, "src/a")})
+
+	// The source was deleted even though the request failed, so the copy is the only one left
+	result, err := client.RenamePrefix(context.Background(), "bucket", "src/", "dst/", &RenamePrefixOptions{OnFailure: RenameRollback})
+	if err != nil {
+		t.Fatalf("RenamePrefix() error = %v", err)
+	}
+	if len(result.Moved) != 1 || len(result.RolledBack) != 0 {
+		t.Errorf("RenamePrefix() moved %v and rolled back %v, want src/a moved", result.Moved, result.RolledBack)
+	}
+	if _, ok := storage.object("dst/a"); !ok {
+		t.Error("the only copy of the object was rolled back")
+	}
+}
+
+func TestRenamePrefixRollbackRecopiesOnResume(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("src/a", testData(100, 1))
+	journalPath := filepath.Join(t.TempDir(), "rename.journal")
+	options := &RenamePrefixOptions{JournalPath: journalPath, OnFailure: RenameRollback}
+
+	client := newTestClientWith(t, storage, []grpc.ServerOption{failDeletes(false, "src/a")})
+	result, err := client.RenamePrefix(context.Background(), "bucket", "src/", "dst/", options)
+	if err == nil {
+		t.Fatal("RenamePrefix() succeeded, want the failed delete reported")
+	}
+	if len(result.RolledBack) != 1 {
+		t.Fatalf("RenamePrefix() rolled back %v, want dst/a", result.RolledBack)
+	}
+	if _, ok := storage.object("dst/a"); ok {
+		t.Error("dst/a still exists after the rollback")
+	}
+	entries := readJournal(t, journalPath)
+	if last := entries[len(entries)-1]; last.Source != "src/a" || last.State != journalRolledBack {
+		t.Errorf("last journal entry = %+v, want src/a rolled back", last)
+	}
+
+	// The rerun must copy the object again rather than trust the journaled copy
+	client = newTestClient(t, storage)
+	if _, err := client.RenamePrefix(context.Background(), "bucket", "src/", "dst/", options); err != nil {
+		t.Fatalf("resumed RenamePrefix() error = %v", err)
+	}
+	if _, ok := storage.object("src/a"); ok {
+		t.Error("src/a still exists after the resumed rename")
+	}
+	if _, ok := storage.object("dst/a"); !ok {
+		t.Error("resumed rename deleted src/a without copying it again")
+	}
+}
+
+func TestRenamePrefixResumeVerifiesJournaledCopies(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("src/a", testData(100, 1))
+	storage.put("src/b", testData(100, 2))
+	a, _ := storage.object("src/a")
+	b, _ := storage.object("src/b")
+	storage.put("dst/b", b.data)
+
+	// Both copies are journaled, but the copy of src/a has since disappeared
+	journalPath := filepath.Join(t.TempDir(), "rename.journal")
+	var journal []byte
+	for _, entry := range []moveJournalEntry{
+		{Source: "src/a", Destination: "dst/a", ETag: a.etag, DestinationETag: a.etag, State: journalCopied},
+		{Source: "src/b", Destination: "dst/b", ETag: b.etag, DestinationETag: b.etag, State: journalCopied},
+	} {
+		line, _ := json.Marshal(entry)
+		journal = append(append(journal, line...), '\n')
+	}
+	if err := os.WriteFile(journalPath, journal, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	client := newTestClient(t, storage)
+	result, err := client.RenamePrefix(context.Background(), "bucket", "src/", "dst/", &RenamePrefixOptions{JournalPath: journalPath})
+	if err != nil {
+		t.Fatalf("RenamePrefix() error = %v", err)
+	}
+	if len(result.Moved) != 2 {
+		t.Errorf("RenamePrefix() moved %v, want src/a and src/b", result.Moved)
+	}
+	for _, key := range []string{"dst/a", "dst/b"} {
+		if _, ok := storage.object(key); !ok {
+			t.Errorf("%s is missing after the rename", key)
+		}
+	}
+	if got := storage.called("CopyObject"); got != 1 {
+		t.Errorf("CopyObject called %d times, want 1 (only the missing copy)", got)
+	}
+	if _, err := os.Stat(journalPath); !os.IsNotExist(err) {
+		t.Errorf("journal still exists after a successful rename: %v", err)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+)
+
+// Multipart constants
+const (
+	maxMultipartParts  = 10000                  // Maximum number of parts in an upload
+	minMultipartPart   = 5 * 1024 * 1024        // 5MB minimum part size (except the last part)
+	maxMultipartPart   = 5 * 1024 * 1024 * 1024 // 5GB maximum part size
+	defaultConcurrency = 8                      // Parts transferred in parallel
+	partChunkSize      = 4 * 1024 * 1024        // 4MB stream chunks within a part
+)
+
+// completedPart records a part that has been uploaded or copied.
+type completedPart struct {
+	PartNumber int32  `json:"part_number"`
+	ETag       string `json:"etag"`
+}
+
+// multipartResult is the outcome of completing a multipart upload.
+type multipartResult struct {
+	etag         string
+	versionID    string
+	lastModified time.Time
+}
+
+// multipartPartSize returns a part size of at least partSize that splits size into at most maxMultipartParts parts.
+func multipartPartSize(size, partSize int64) int64 {
+	if partSize < minMultipartPart {
+		partSize = minMultipartPart
+	}
+	if minimum := (size + maxMultipartParts - 1) / maxMultipartParts; partSize < minimum {
+		partSize = minimum
+	}
+	if partSize > maxMultipartPart {
+		partSize = maxMultipartPart
+	}
+	return partSize
+}
+
+// createMultipartUpload starts a multipart upload and returns its ID.
+// The encryption, if set, applies to the completed object.
+func (client *ACSClient) createMultipartUpload(ctx context.Context, bucket, key, contentType string, userMetadata map[string]string, encryption *objectEncryption) (string, error) {
+	return withRetry(ctx, client.retry.singleAttempt(), func(ctx context.Context) (string, error) {
+		req := &pb.CreateMultipartUploadRequest{
+			Bucket:       bucket,
+			Key:          key,
+			UserMetadata: userMetadata,
+		}
+		if contentType != "" {
+			req.ContentType = &contentType
+		}
+		if encryption != nil {
+			if encryption.algorithm != "" {
+				req.ServerSideEncryption = &encryption.algorithm
+			}
+			if encryption.kmsKeyID != "" {
+				req.SseKmsKeyId = &encryption.kmsKeyID
+			}
+			req.SseCustomerKey = sseCustomerKey(encryption.customerKey)
+		}
+
+		resp, err := client.client.CreateMultipartUpload(ctx, req)
+		if err != nil {
+			return "", fmt.Errorf("failed to create multipart upload: %w", err)
+		}
+
+		return resp.UploadId, nil
+	})
+}
+
+// uploadPart uploads one part of a multipart upload, streaming it in chunks, and returns its ETag.
+// The checksum, if set, is the hex SHA-256 of the part and is verified by the server.
+func (client *ACSClient) uploadPart(ctx context.Context, bucket, key, uploadID string, partNumber int32, data []byte, checksum string) (string, error) {
+	return withRetry(ctx, client.retry, func(ctx context.Context) (string, error) {
+		stream, err := client.client.UploadPart(withSigningResource(ctx, bucket, key))
+		if err != nil {
+			return "", fmt.Errorf("failed to start UploadPart stream: %w", err)
+		}
+
+		input := &pb.UploadPartInput{
+			Bucket:     bucket,
+			Key:        key,
+			UploadId:   uploadID,
+			PartNumber: partNumber,
+		}
+		if checksum != "" {
+			input.ChecksumSha256 = &checksum
+		}
+		err = stream.Send(&pb.UploadPartRequest{
+			Data: &pb.UploadPartRequest_Parameters{Parameters: input},
+		})
+		if err == io.EOF {
+			// The server ended the stream; its status is only reported by CloseAndRecv
+			_, err = stream.CloseAndRecv()
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to send part parameters: %w", err)
+		}
+
+		for i := 0; i < len(data); i += partChunkSize {
+			end := min(i+partChunkSize, len(data))
+			if err := client.limits.upload.wait(ctx, end-i); err != nil {
+				return "", err
+			}
+			err := stream.Send(&pb.UploadPartRequest{
+				Data: &pb.UploadPartRequest_Chunk{Chunk: data[i:end]},
+			})
+			if err == io.EOF {
+				_, err = stream.CloseAndRecv()
+			}
+			if err != nil {
+				return "", fmt.Errorf("failed to send part %d chunk: %w", partNumber, err)
+			}
+		}
+
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			return "", fmt.Errorf("failed to upload part %d: %w", partNumber, err)
+		}
+
+		return resp.Etag, nil
+	})
+}
+
+// completeMultipartUpload assembles the uploaded parts into the final object.
+func (client *ACSClient) completeMultipartUpload(ctx context.Context, bucket, key, uploadID string, parts []completedPart) (*multipartResult, error) {
+	sorted := make([]completedPart, len(parts))
+	copy(sorted, parts)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].PartNumber < sorted[j].PartNumber
+	})
+
+	return withRetry(ctx, client.retry.singleAttempt(), func(ctx context.Context) (*multipartResult, error) {
+		req := &pb.CompleteMultipartUploadRequest{
+			Bucket:   bucket,
+			Key:      key,
+			UploadId: uploadID,
+			Parts:    make([]*pb.CompletedPart, len(sorted)),
+		}
+		for i, part := range sorted {
+			req.Parts[i] = &pb.CompletedPart{PartNumber: part.PartNumber, Etag: part.ETag}
+		}
+
+		resp, err := client.client.CompleteMultipartUpload(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to complete multipart upload: %w", err)
+		}
+
+		result := &multipartResult{
+			etag:      resp.Etag,
+			versionID: resp.VersionId,
+		}
+		if resp.LastModified != nil {
+			result.lastModified = resp.LastModified.AsTime()
+		}
+		return result, nil
+	})
+}
+
+// abortMultipartUpload discards a multipart upload and its parts.
+// It uses a fresh context so that cleanup still happens after the caller's context is cancelled.
+func (client *ACSClient) abortMultipartUpload(bucket, key, uploadID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		req := &pb.AbortMultipartUploadRequest{
+			Bucket:   bucket,
+			Key:      key,
+			UploadId: uploadID,
+		}
+
+		_, err := client.client.AbortMultipartUpload(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to abort multipart upload: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,834 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+)
+
+// crossRegionCopyPartSize is the size of each part a copy across regions downloads and uploads.
+const crossRegionCopyPartSize = 64 * 1024 * 1024 // 64MB
+
+// MultiRegionClient routes every operation to a client authenticated in the region of the bucket it acts on.
+// Bucket regions are resolved with HeadBucket and cached; one connection is opened per region on first use.
+// Operations that do not name a bucket use the session's region.
+type MultiRegionClient struct {
+	session       Session
+	defaultRegion string
+
+	mu         sync.Mutex
+	clients    map[string]*ACSClient    // Region to client
+	connecting map[string]chan struct{} // Regions being connected, closed once the attempt finishes
+	regions    map[string]string        // Bucket to region
+
+	limits *rateLimiter // Bandwidth and request limits shared by the clients of every region
+}
+
+// NewMultiRegionClient creates a multi-region client.
+// The session's region (default "us-east-1") is connected immediately and used for operations without a bucket;
+// other regions share the rest of the session configuration.
+func NewMultiRegionClient(session *Session) (*MultiRegionClient, error) {
+	multi := &MultiRegionClient{
+		defaultRegion: defaultRegion,
+		clients:       make(map[string]*ACSClient),
+		connecting:    make(map[string]chan struct{}),
+		regions:       make(map[string]string),
+	}
+	if session != nil {
+		multi.session = *session
+		if session.Region != "" {
+			multi.defaultRegion = session.Region
+		}
+	}
+	multi.session.Region = multi.defaultRegion
+	multi.limits = newRateLimiter(multi.session.Limits)
+
+	client, err := newClient(&multi.session, multi.limits)
+	if err != nil {
+		return nil, err
+	}
+	multi.clients[multi.defaultRegion] = client
+	return multi, nil
+}
+
+// Client returns the client for a region, connecting and authenticating it on first use.
+// The connection is made without holding the lock, so other regions stay usable meanwhile;
+// concurrent callers for the same region wait for a single connection attempt.
+func (multi *MultiRegionClient) Client(region string) (*ACSClient, error) {
+	if region == "" {
+		region = multi.defaultRegion
+	}
+
+	multi.mu.Lock()
+	for {
+		if client, ok := multi.clients[region]; ok {
+			multi.mu.Unlock()
+			return client, nil
+		}
+		done, ok := multi.connecting[region]
+		if !ok {
+			break
+		}
+		// Another caller is connecting; use its client, or try again if it failed
+		multi.mu.Unlock()
+		<-done
+		multi.mu.Lock()
+	}
+	done := make(chan struct{})
+	multi.connecting[region] = done
+	multi.mu.Unlock()
+
+	// Only the default region's client rotates the shared key; the others pick it up on re-authentication
+	session := multi.session
+	session.Region = region
+	session.KeyRotationInterval = -1
+
+	client, err := newClient(&session, multi.limits)
+
+	multi.mu.Lock()
+	delete(multi.connecting, region)
+	if err == nil {
+		multi.clients[region] = client
+	}
+	multi.mu.Unlock()
+	close(done)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to region %s: %w", region, err)
+	}
+	return client, nil
+}
+
+// BucketRegion returns the region of a bucket, looking it up with HeadBucket if it is not cached.
+func (multi *MultiRegionClient) BucketRegion(ctx context.Context, bucket string) (string, error) {
+	multi.mu.Lock()
+	region, ok := multi.regions[bucket]
+	defaultClient := multi.clients[multi.defaultRegion]
+	multi.mu.Unlock()
+	if ok {
+		return region, nil
+	}
+
+	output, err := defaultClient.HeadBucket(ctx, bucket)
+	if err != nil {
+		return "", err
+	}
+	region = output.Region
+	if region == "" {
+		region = multi.defaultRegion
+	}
+
+	multi.setBucketRegion(bucket, region)
+	return region, nil
+}
+
+// ClientForBucket returns the client for the region of a bucket.
+func (multi *MultiRegionClient) ClientForBucket(ctx context.Context, bucket string) (*ACSClient, error) {
+	region, err := multi.BucketRegion(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return multi.Client(region)
+}
+
+// setBucketRegion caches the region of a bucket.
+func (multi *MultiRegionClient) setBucketRegion(bucket, region string) {
+	multi.mu.Lock()
+	defer multi.mu.Unlock()
+	multi.regions[bucket] = region
+}
+
+// forgetBucket removes a bucket from the region cache.
+func (multi *MultiRegionClient) forgetBucket(bucket string) {
+	multi.mu.Lock()
+	defer multi.mu.Unlock()
+	delete(multi.regions, bucket)
+}
+
+// Close closes the connections to every region.
+func (multi *MultiRegionClient) Close() error {
+	multi.mu.Lock()
+	defer multi.mu.Unlock()
+
+	var firstErr error
+	for region, client := range multi.clients {
+		if err := client.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		delete(multi.clients, region)
+	}
+	return firstErr
+}
+
+// CreateBucket creates a bucket in the session's region.
+func (multi *MultiRegionClient) CreateBucket(ctx context.Context, bucket string) error {
+	return multi.CreateBucketInRegion(ctx, multi.defaultRegion, bucket)
+}
+
+// CreateBucketInRegion creates a bucket in the given region.
+func (multi *MultiRegionClient) CreateBucketInRegion(ctx context.Context, region, bucket string) error {
+	client, err := multi.Client(region)
+	if err != nil {
+		return err
+	}
+	if err := client.CreateBucket(ctx, bucket); err != nil {
+		return err
+	}
+	multi.setBucketRegion(bucket, region)
+	return nil
+}
+
+// DeleteBucket deletes a bucket in its region.
+func (multi *MultiRegionClient) DeleteBucket(ctx context.Context, bucket string) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if err := client.DeleteBucket(ctx, bucket); err != nil {
+		return err
+	}
+	multi.forgetBucket(bucket)
+	return nil
+}
+
+// ListBuckets lists the buckets in every region and caches their regions.
+func (multi *MultiRegionClient) ListBuckets(ctx context.Context) ([]*pb.Bucket, error) {
+	client, err := multi.Client(multi.defaultRegion)
+	if err != nil {
+		return nil, err
+	}
+	buckets, err := client.ListBuckets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, bucket := range buckets {
+		if bucket.BucketRegion != "" {
+			multi.setBucketRegion(bucket.Name, bucket.BucketRegion)
+		}
+	}
+	return buckets, nil
+}
+
+// HeadBucket retrieves a bucket's metadata from its region.
+func (multi *MultiRegionClient) HeadBucket(ctx context.Context, bucket string) (*HeadBucketOutput, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.HeadBucket(ctx, bucket)
+}
+
+// ShareBucket shares a bucket in its region.
+func (multi *MultiRegionClient) ShareBucket(ctx context.Context, bucket string) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.ShareBucket(ctx, bucket)
+}
+
+// PutObject uploads an object in its bucket's region.
+func (multi *MultiRegionClient) PutObject(ctx context.Context, bucket, key string, data []byte, options ...ObjectOption) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.PutObject(ctx, bucket, key, data, options...)
+}
+
+// GetObject downloads an object from its bucket's region.
+func (multi *MultiRegionClient) GetObject(ctx context.Context, bucket, key string, options ...GetObjectOption) ([]byte, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetObject(ctx, bucket, key, options...)
+}
+
+// HeadObject retrieves an object's metadata from its bucket's region.
+func (multi *MultiRegionClient) HeadObject(ctx context.Context, bucket, key string, options ...ObjectOption) (*HeadObjectOutput, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.HeadObject(ctx, bucket, key, options...)
+}
+
+// DeleteObject deletes an object in its bucket's region.
+func (multi *MultiRegionClient) DeleteObject(ctx context.Context, bucket, key string, options ...ObjectOption) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.DeleteObject(ctx, bucket, key, options...)
+}
+
+// DeleteObjects deletes objects in their bucket's region.
+func (multi *MultiRegionClient) DeleteObjects(ctx context.Context, bucket string, keys []string) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.DeleteObjects(ctx, bucket, keys)
+}
+
+// ListObjects lists the keys of a bucket in its region.
+func (multi *MultiRegionClient) ListObjects(ctx context.Context, bucket string, opts *ListObjectsOptions) ([]string, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.ListObjects(ctx, bucket, opts)
+}
+
+// ListObjectSummaries lists the objects of a bucket in its region.
+func (multi *MultiRegionClient) ListObjectSummaries(ctx context.Context, bucket string, opts *ListObjectsOptions) ([]*pb.ObjectSummary, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.ListObjectSummaries(ctx, bucket, opts)
+}
+
+// ListObjectVersions lists the object versions of a bucket in its region.
+func (multi *MultiRegionClient) ListObjectVersions(ctx context.Context, bucket string, opts *ListObjectVersionsOptions) ([]ObjectVersion, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.ListObjectVersions(ctx, bucket, opts)
+}
+
+// PutBucketVersioning sets the versioning state of a bucket in its region.
+func (multi *MultiRegionClient) PutBucketVersioning(ctx context.Context, bucket, versioningStatus string) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.PutBucketVersioning(ctx, bucket, versioningStatus)
+}
+
+// GetBucketVersioning returns the versioning state of a bucket from its region.
+func (multi *MultiRegionClient) GetBucketVersioning(ctx context.Context, bucket string) (string, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return "", err
+	}
+	return client.GetBucketVersioning(ctx, bucket)
+}
+
+// PutBucketLifecycle sets the lifecycle rules of a bucket in its region.
+func (multi *MultiRegionClient) PutBucketLifecycle(ctx context.Context, bucket string, rules []LifecycleRule) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.PutBucketLifecycle(ctx, bucket, rules)
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket from its region.
+func (multi *MultiRegionClient) GetBucketLifecycle(ctx context.Context, bucket string) ([]LifecycleRule, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBucketLifecycle(ctx, bucket)
+}
+
+// DeleteBucketLifecycle removes the lifecycle rules of a bucket in its region.
+func (multi *MultiRegionClient) DeleteBucketLifecycle(ctx context.Context, bucket string) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.DeleteBucketLifecycle(ctx, bucket)
+}
+
+// PutBucketPolicy sets the access policy of a bucket in its region.
+func (multi *MultiRegionClient) PutBucketPolicy(ctx context.Context, bucket string, policy *BucketPolicy) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.PutBucketPolicy(ctx, bucket, policy)
+}
+
+// GetBucketPolicy returns the access policy of a bucket from its region.
+func (multi *MultiRegionClient) GetBucketPolicy(ctx context.Context, bucket string) (*BucketPolicy, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBucketPolicy(ctx, bucket)
+}
+
+// DeleteBucketPolicy removes the access policy of a bucket in its region.
+func (multi *MultiRegionClient) DeleteBucketPolicy(ctx context.Context, bucket string) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.DeleteBucketPolicy(ctx, bucket)
+}
+
+// PutObjectTagging replaces the tags of an object in its bucket's region.
+func (multi *MultiRegionClient) PutObjectTagging(ctx context.Context, bucket, key string, tags map[string]string, options ...ObjectOption) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.PutObjectTagging(ctx, bucket, key, tags, options...)
+}
+
+// GetObjectTagging returns the tags of an object from its bucket's region.
+func (multi *MultiRegionClient) GetObjectTagging(ctx context.Context, bucket, key string, options ...ObjectOption) (map[string]string, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetObjectTagging(ctx, bucket, key, options...)
+}
+
+// DeleteObjectTagging removes the tags of an object in its bucket's region.
+func (multi *MultiRegionClient) DeleteObjectTagging(ctx context.Context, bucket, key string, options ...ObjectOption) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.DeleteObjectTagging(ctx, bucket, key, options...)
+}
+
+// ResumableUpload uploads a local file to its bucket's region as a resumable multipart upload.
+func (multi *MultiRegionClient) ResumableUpload(ctx context.Context, bucket, key, filePath string, opts *ResumableUploadOptions) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.ResumableUpload(ctx, bucket, key, filePath, opts)
+}
+
+// HeadObjects retrieves the metadata of several objects from their bucket's region.
+func (multi *MultiRegionClient) HeadObjects(ctx context.Context, bucket string, keys []string) ([]HeadObjectsResult, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.HeadObjects(ctx, bucket, keys)
+}
+
+// GetObjects downloads several objects from their bucket's region.
+func (multi *MultiRegionClient) GetObjects(ctx context.Context, bucket string, keys []string) ([]GetObjectsResult, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetObjects(ctx, bucket, keys)
+}
+
+// Glob lists the objects of a bucket in its region whose keys match pattern.
+// If the bucket's region cannot be resolved, the sequence yields only that error.
+func (multi *MultiRegionClient) Glob(ctx context.Context, bucket, pattern string) iter.Seq2[*pb.ObjectSummary, error] {
+	return func(yield func(*pb.ObjectSummary, error) bool) {
+		client, err := multi.ClientForBucket(ctx, bucket)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		client.Glob(ctx, bucket, pattern)(yield)
+	}
+}
+
+// ListObjectsRegexp lists the objects of a bucket in its region whose keys match re.
+// If the bucket's region cannot be resolved, the sequence yields only that error.
+func (multi *MultiRegionClient) ListObjectsRegexp(ctx context.Context, bucket string, re *regexp.Regexp) iter.Seq2[*pb.ObjectSummary, error] {
+	return func(yield func(*pb.ObjectSummary, error) bool) {
+		client, err := multi.ClientForBucket(ctx, bucket)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		client.ListObjectsRegexp(ctx, bucket, re)(yield)
+	}
+}
+
+// PutObjectLockConfiguration sets the object lock configuration of a bucket in its region.
+func (multi *MultiRegionClient) PutObjectLockConfiguration(ctx context.Context, bucket string, config ObjectLockConfiguration) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.PutObjectLockConfiguration(ctx, bucket, config)
+}
+
+// GetObjectLockConfiguration retrieves the object lock configuration of a bucket from its region.
+func (multi *MultiRegionClient) GetObjectLockConfiguration(ctx context.Context, bucket string) (*ObjectLockConfiguration, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetObjectLockConfiguration(ctx, bucket)
+}
+
+// PutObjectRetention sets the retention of an object in its bucket's region.
+func (multi *MultiRegionClient) PutObjectRetention(ctx context.Context, bucket, key string, retention ObjectRetention, options ...ObjectOption) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.PutObjectRetention(ctx, bucket, key, retention, options...)
+}
+
+// GetObjectRetention retrieves the retention of an object from its bucket's region.
+func (multi *MultiRegionClient) GetObjectRetention(ctx context.Context, bucket, key string, options ...ObjectOption) (*ObjectRetention, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetObjectRetention(ctx, bucket, key, options...)
+}
+
+// PutObjectLegalHold places or removes a legal hold on an object in its bucket's region.
+func (multi *MultiRegionClient) PutObjectLegalHold(ctx context.Context, bucket, key string, on bool, options ...ObjectOption) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.PutObjectLegalHold(ctx, bucket, key, on, options...)
+}
+
+// GetObjectLegalHold reports whether an object in its bucket's region is under legal hold.
+func (multi *MultiRegionClient) GetObjectLegalHold(ctx context.Context, bucket, key string, options ...ObjectOption) (bool, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return false, err
+	}
+	return client.GetObjectLegalHold(ctx, bucket, key, options...)
+}
+
+// PutBucketEncryption sets the default encryption of a bucket in its region.
+func (multi *MultiRegionClient) PutBucketEncryption(ctx context.Context, bucket string, encryption BucketEncryption) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.PutBucketEncryption(ctx, bucket, encryption)
+}
+
+// GetBucketEncryption retrieves the default encryption of a bucket from its region.
+func (multi *MultiRegionClient) GetBucketEncryption(ctx context.Context, bucket string) (*BucketEncryption, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBucketEncryption(ctx, bucket)
+}
+
+// DeleteBucketEncryption removes the default encryption of a bucket in its region.
+func (multi *MultiRegionClient) DeleteBucketEncryption(ctx context.Context, bucket string) error {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	return client.DeleteBucketEncryption(ctx, bucket)
+}
+
+// SelectObjectContent runs a SQL expression over an object in its bucket's region.
+// If the bucket's region cannot be resolved, the sequence yields only that error.
+func (multi *MultiRegionClient) SelectObjectContent(ctx context.Context, input SelectObjectInput) iter.Seq2[*SelectEvent, error] {
+	return func(yield func(*SelectEvent, error) bool) {
+		client, err := multi.ClientForBucket(ctx, input.Bucket)
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		client.SelectObjectContent(ctx, input)(yield)
+	}
+}
+
+// defaultClient returns the client of the session's region, or nil once the client is closed.
+func (multi *MultiRegionClient) defaultClient() *ACSClient {
+	multi.mu.Lock()
+	defer multi.mu.Unlock()
+	return multi.clients[multi.defaultRegion]
+}
+
+// SetUploadLimit changes the upload bandwidth limit in bytes per second, shared by every region;
+// zero removes the limit.
+func (multi *MultiRegionClient) SetUploadLimit(bytesPerSecond int64) {
+	multi.limits.upload.setRate(float64(bytesPerSecond))
+}
+
+// SetDownloadLimit changes the download bandwidth limit in bytes per second, shared by every region;
+// zero removes the limit.
+func (multi *MultiRegionClient) SetDownloadLimit(bytesPerSecond int64) {
+	multi.limits.download.setRate(float64(bytesPerSecond))
+}
+
+// SetRequestLimit changes the request rate limit of an operation, named by its RPC such as "PutObject",
+// shared by every region; zero removes the limit.
+func (multi *MultiRegionClient) SetRequestLimit(operation string, requestsPerSecond float64) {
+	multi.limits.setRequestRate(operation, requestsPerSecond)
+}
+
+// ChannelStats returns the current load of each channel to the session's region.
+// Use Client(region).ChannelStats() for other regions.
+func (multi *MultiRegionClient) ChannelStats() []ChannelStats {
+	client := multi.defaultClient()
+	if client == nil {
+		return nil
+	}
+	return client.ChannelStats()
+}
+
+// CircuitState returns the state of the circuit breaker of the session's region.
+// Use Client(region).CircuitState() for other regions.
+func (multi *MultiRegionClient) CircuitState() CircuitState {
+	client := multi.defaultClient()
+	if client == nil {
+		return CircuitClosed
+	}
+	return client.CircuitState()
+}
+
+// ConcurrencyLimit returns the adaptive concurrency limit and the number of requests in flight in the
+// session's region. Use Client(region).ConcurrencyLimit() for other regions.
+func (multi *MultiRegionClient) ConcurrencyLimit() (limit, inFlight int) {
+	client := multi.defaultClient()
+	if client == nil {
+		return 0, 0
+	}
+	return client.ConcurrencyLimit()
+}
+
+// RotateKey checks whether key rotation is needed and performs it if necessary, using the session's region.
+// The key is shared by every region; the other regions' clients pick up the new secret on re-authentication.
+func (multi *MultiRegionClient) RotateKey(ctx context.Context, force bool) error {
+	client, err := multi.Client(multi.defaultRegion)
+	if err != nil {
+		return err
+	}
+	return client.RotateKey(ctx, force)
+}
+
+// BucketFS returns a read-only fs.FS backed by a bucket in its region.
+func (multi *MultiRegionClient) BucketFS(ctx context.Context, bucket string) (*BucketFS, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.BucketFS(ctx, bucket), nil
+}
+
+// RenamePrefix moves every object under srcPrefix to dstPrefix within a bucket in its region.
+func (multi *MultiRegionClient) RenamePrefix(ctx context.Context, bucket, srcPrefix, dstPrefix string, opts *RenamePrefixOptions) (*RenamePrefixResult, error) {
+	client, err := multi.ClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return client.RenamePrefix(ctx, bucket, srcPrefix, dstPrefix, opts)
+}
+
+// CopyObject copies an object, given as "bucket/key" in copySource, to a destination bucket and key.
+// Copies within a region are performed server-side; copies across regions stream the source from its
+// region into a multipart upload in the destination's region, keeping its metadata, tags and object lock settings.
+func (multi *MultiRegionClient) CopyObject(ctx context.Context, bucket, copySource, key string, options ...ObjectOption) error {
+	srcBucket, srcKey, ok := strings.Cut(copySource, "/")
+	if !ok {
+		return fmt.Errorf("invalid copy source %q: must be bucket/key", copySource)
+	}
+
+	src, dst, err := multi.clientsForCopy(ctx, srcBucket, bucket)
+	if err != nil {
+		return err
+	}
+	if src == dst {
+		return dst.CopyObject(ctx, bucket, copySource, key, options...)
+	}
+
+	_, err = multi.copyAcrossRegions(ctx, src, dst, srcBucket, srcKey, bucket, key, options...)
+	return err
+}
+
+// CopyObjectWithInput performs a server-side copy within a region.
+// Copies across regions are not supported; use CopyObject instead.
+func (multi *MultiRegionClient) CopyObjectWithInput(ctx context.Context, input CopyObjectInput) (*CopyObjectOutput, error) {
+	src, dst, err := multi.clientsForCopy(ctx, input.SourceBucket, input.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	if src != dst {
+		return nil, fmt.Errorf("cannot copy from %s to %s with CopyObjectWithInput: buckets are in different regions", input.SourceBucket, input.Bucket)
+	}
+	return dst.CopyObjectWithInput(ctx, input)
+}
+
+// MoveObject moves an object, copying it across regions when the buckets are in different regions.
+// The source is only deleted once the destination has the source's size.
+func (multi *MultiRegionClient) MoveObject(ctx context.Context, srcBucket, srcKey, dstBucket, dstKey string) error {
+	src, dst, err := multi.clientsForCopy(ctx, srcBucket, dstBucket)
+	if err != nil {
+		return err
+	}
+	if src == dst {
+		return dst.MoveObject(ctx, srcBucket, srcKey, dstBucket, dstKey)
+	}
+
+	size, err := multi.copyAcrossRegions(ctx, src, dst, srcBucket, srcKey, dstBucket, dstKey)
+	if err != nil {
+		return err
+	}
+
+	dest, err := dst.HeadObject(ctx, dstBucket, dstKey)
+	if err != nil {
+		return fmt.Errorf("failed to verify copy: %w", err)
+	}
+	if dest.ContentLength != size {
+		return fmt.Errorf("copy of %s has %d bytes, expected %d", srcKey, dest.ContentLength, size)
+	}
+
+	if err := src.DeleteObject(ctx, srcBucket, srcKey); err != nil {
+		return fmt.Errorf("copied to %s/%s but failed to delete source: %w", dstBucket, dstKey, err)
+	}
+	return nil
+}
+
+// clientsForCopy returns the clients for the source and destination buckets of a copy.
+func (multi *MultiRegionClient) clientsForCopy(ctx context.Context, srcBucket, dstBucket string) (*ACSClient, *ACSClient, error) {
+	src, err := multi.ClientForBucket(ctx, srcBucket)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve region of %s: %w", srcBucket, err)
+	}
+	dst, err := multi.ClientForBucket(ctx, dstBucket)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve region of %s: %w", dstBucket, err)
+	}
+	return src, dst, nil
+}
+
+// copyAcrossRegions copies an object from the source region to the destination region as a multipart upload,
+// downloading and uploading one part at a time so that the object is never held in memory whole.
+// Every part is pinned to the source ETag observed up front, so a source that changes mid-copy fails the copy.
+// The content type, user metadata, tags, retention and legal hold of the source are carried over, and so is
+// its server-side encryption algorithm unless the options select another encryption; KMS keys are regional,
+// so the destination region's default key is used unless WithSSEKMS names one.
+// WithVersionID selects the version of the source. It returns the number of bytes copied.
+func (multi *MultiRegionClient) copyAcrossRegions(ctx context.Context, src, dst *ACSClient, srcBucket, srcKey, dstBucket, dstKey string, options ...ObjectOption) (int64, error) {
+	opts, err := applyObjectOptions("CopyObject", copyObjectOptions, options)
+	if err != nil {
+		return 0, err
+	}
+	if err := opts.encryption.validate(); err != nil {
+		return 0, err
+	}
+
+	var versionOptions []ObjectOption
+	if opts.versionID != "" {
+		versionOptions = append(versionOptions, WithVersionID(opts.versionID))
+	}
+	headOptions := versionOptions
+	if opts.encryption.sourceKey != nil {
+		headOptions = append(headOptions[:len(headOptions):len(headOptions)], WithSSECustomerKey(opts.encryption.sourceKey))
+	}
+	source, err := src.HeadObject(ctx, srcBucket, srcKey, headOptions...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read copy source: %w", err)
+	}
+	var tags map[string]string
+	if source.TagCount > 0 {
+		if tags, err = src.GetObjectTagging(ctx, srcBucket, srcKey, versionOptions...); err != nil {
+			return 0, fmt.Errorf("failed to read copy source tags: %w", err)
+		}
+	}
+
+	encryption := &objectEncryption{
+		algorithm:   opts.encryption.algorithm,
+		kmsKeyID:    opts.encryption.kmsKeyID,
+		customerKey: opts.encryption.customerKey,
+	}
+	if encryption.algorithm == "" && encryption.customerKey == nil &&
+		(source.ServerSideEncryption == SSEAES256 || source.ServerSideEncryption == SSEKMS) {
+		encryption.algorithm = source.ServerSideEncryption
+	}
+
+	uploadID, err := dst.createMultipartUpload(ctx, dstBucket, dstKey, source.ContentType, source.UserMetadata, encryption)
+	if err != nil {
+		return 0, fmt.Errorf("failed to write copy destination: %w", err)
+	}
+	result, err := multi.copyPartsAcrossRegions(ctx, src, dst, srcBucket, srcKey, dstBucket, dstKey, uploadID, source, opts)
+	if err != nil {
+		if abortErr := dst.abortMultipartUpload(dstBucket, dstKey, uploadID); abortErr != nil {
+			fmt.Printf("Warning: Failed to abort cross-region copy: %v\n", abortErr)
+		}
+		return 0, fmt.Errorf("failed to write copy destination: %w", err)
+	}
+
+	// Tags and object lock settings are not part of a multipart upload, so apply them to the new version
+	var destOptions []ObjectOption
+	if result.versionID != "" {
+		destOptions = append(destOptions, WithVersionID(result.versionID))
+	}
+	if len(tags) > 0 {
+		if err := dst.PutObjectTagging(ctx, dstBucket, dstKey, tags, destOptions...); err != nil {
+			return 0, fmt.Errorf("copied to %s/%s but failed to copy tags: %w", dstBucket, dstKey, err)
+		}
+	}
+	if source.ObjectLockMode != "" && source.ObjectLockRetainUntil.After(time.Now()) {
+		retention := ObjectRetention{Mode: source.ObjectLockMode, RetainUntil: source.ObjectLockRetainUntil}
+		if err := dst.PutObjectRetention(ctx, dstBucket, dstKey, retention, destOptions...); err != nil {
+			return 0, fmt.Errorf("copied to %s/%s but failed to copy retention: %w", dstBucket, dstKey, err)
+		}
+	}
+	if source.ObjectLockLegalHold {
+		if err := dst.PutObjectLegalHold(ctx, dstBucket, dstKey, true, destOptions...); err != nil {
+			return 0, fmt.Errorf("copied to %s/%s but failed to copy legal hold: %w", dstBucket, dstKey, err)
+		}
+	}
+
+	return source.ContentLength, nil
+}
+
+// copyPartsAcrossRegions downloads the source one part at a time, uploads each part and completes the upload.
+func (multi *MultiRegionClient) copyPartsAcrossRegions(ctx context.Context, src, dst *ACSClient, srcBucket, srcKey, dstBucket, dstKey, uploadID string, source *HeadObjectOutput, opts *ObjectOptions) (*multipartResult, error) {
+	partSize := multipartPartSize(source.ContentLength, crossRegionCopyPartSize)
+	// An empty object is still uploaded as a single, empty part
+	partCount := max(1, int((source.ContentLength+partSize-1)/partSize))
+
+	parts := make([]completedPart, partCount)
+	for index := range parts {
+		start := int64(index) * partSize
+		end := min(start+partSize, source.ContentLength) - 1
+
+		var data []byte
+		if end >= start {
+			var err error
+			data, err = src.getObject(ctx, srcBucket, srcKey, &GetObjectOptions{
+				rangeSpec:  fmt.Sprintf("bytes=%d-%d", start, end),
+				versionID:  opts.versionID,
+				ifMatch:    source.ETag,
+				encryption: objectEncryption{customerKey: opts.encryption.sourceKey},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to read part %d of copy source: %w", index+1, err)
+			}
+			if int64(len(data)) != end-start+1 {
+				return nil, fmt.Errorf("read %d bytes of part %d of copy source, expected %d", len(data), index+1, end-start+1)
+			}
+		}
+
+		etag, err := dst.uploadPart(ctx, dstBucket, dstKey, uploadID, int32(index+1), data, "")
+		if err != nil {
+			return nil, err
+		}
+		parts[index] = completedPart{PartNumber: int32(index + 1), ETag: etag}
+	}
+
+	return dst.completeMultipartUpload(ctx, dstBucket, dstKey, uploadID, parts)
+}
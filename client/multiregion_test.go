@@ -0,0 +1,77 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestCopyAcrossRegionsKeepsMetadata(t *testing.T) {
+	srcStorage, dstStorage := newFakeStorage(), newFakeStorage()
+	object := testData(10000, 1)
+	srcStorage.putObject("object", fakeObject{
+		data:         object,
+		contentType:  "text/csv",
+		userMetadata: map[string]string{"owner": "analytics"},
+		tags:         map[string]string{"team": "data"},
+	})
+	srcStorage.put("empty", nil)
+	src, dst := newTestClient(t, srcStorage), newTestClient(t, dstStorage)
+	multi := &MultiRegionClient{}
+	ctx := context.Background()
+
+	size, err := multi.copyAcrossRegions(ctx, src, dst, "src", "object", "dst", "copy")
+	if err != nil {
+		t.Fatalf("copyAcrossRegions() error = %v", err)
+	}
+	if size != int64(len(object)) {
+		t.Errorf("copyAcrossRegions() = %d bytes, want %d", size, len(object))
+	}
+
+	copied, ok := dstStorage.object("copy")
+	if !ok {
+		t.Fatal("destination object was not created")
+	}
+	if !bytes.Equal(copied.data, object) {
+		t.Errorf("destination holds %d bytes that differ from the source", len(copied.data))
+	}
+	if copied.contentType != "text/csv" {
+		t.Errorf("destination content type = %q, want %q", copied.contentType, "text/csv")
+	}
+	if want := map[string]string{"owner": "analytics"}; !reflect.DeepEqual(copied.userMetadata, want) {
+		t.Errorf("destination user metadata = %v, want %v", copied.userMetadata, want)
+	}
+	if want := map[string]string{"team": "data"}; !reflect.DeepEqual(copied.tags, want) {
+		t.Errorf("destination tags = %v, want %v", copied.tags, want)
+	}
+
+	// Empty objects are copied as a single empty part
+	if _, err := multi.copyAcrossRegions(ctx, src, dst, "src", "empty", "dst", "empty"); err != nil {
+		t.Fatalf("copyAcrossRegions() of an empty object error = %v", err)
+	}
+	if copied, ok := dstStorage.object("empty"); !ok || len(copied.data) != 0 {
+		t.Errorf("empty destination object = %v, %v; want an empty object", copied.data, ok)
+	}
+}
+
+func TestMultiRegionClientMethodSet(t *testing.T) {
+	multi := reflect.TypeOf(&MultiRegionClient{})
+	client := reflect.TypeOf(&ACSClient{})
+	for i := 0; i < client.NumMethod(); i++ {
+		name := client.Method(i).Name
+		if _, ok := multi.MethodByName(name); !ok {
+			t.Errorf("MultiRegionClient does not forward ACSClient.%s", name)
+		}
+	}
+
+	// Methods that only make sense with several regions
+	multiOnly := map[string]bool{"BucketRegion": true, "Client": true, "ClientForBucket": true, "CreateBucketInRegion": true}
+	for i := 0; i < multi.NumMethod(); i++ {
+		name := multi.Method(i).Name
+		if _, ok := client.MethodByName(name); !ok && !multiOnly[name] {
+			t.Errorf("MultiRegionClient.%s has no ACSClient counterpart", name)
+		}
+	}
+}
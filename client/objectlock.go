@@ -0,0 +1,305 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Object lock retention modes
+const (
+	// RetentionGovernance blocks deletes and overwrites unless the caller bypasses governance retention
+	RetentionGovernance = "GOVERNANCE"
+	// RetentionCompliance blocks deletes and overwrites by anyone until the retention period ends
+	RetentionCompliance = "COMPLIANCE"
+)
+
+// ErrObjectLocked is returned when a delete is blocked by an object's retention or legal hold.
+var ErrObjectLocked = errors.New("object is locked by retention or legal hold")
+
+// ObjectLockConfiguration describes the object lock settings of a bucket.
+type ObjectLockConfiguration struct {
+	// Enabled turns on object lock for the bucket; it cannot be turned off again
+	Enabled bool
+	// DefaultRetention, if set, is applied to new objects written without explicit retention
+	DefaultRetention *DefaultRetention
+}
+
+// DefaultRetention is the retention applied to new objects in a bucket with object lock enabled.
+// Exactly one of Days and Years must be set.
+type DefaultRetention struct {
+	// Mode is RetentionGovernance or RetentionCompliance
+	Mode string
+	// Days is the retention period in days
+	Days int32
+	// Years is the retention period in years
+	Years int32
+}
+
+// ObjectRetention is the retention of a single object version.
+type ObjectRetention struct {
+	// Mode is RetentionGovernance or RetentionCompliance
+	Mode string
+	// RetainUntil is the time until which the object version is protected
+	RetainUntil time.Time
+}
+
+// WithRetention protects an object written by PutObject until the given time.
+func WithRetention(mode string, retainUntil time.Time) ObjectOption {
+	return func(opts *ObjectOptions) {
+		opts.set |= optRetention
+		opts.retention = &ObjectRetention{Mode: mode, RetainUntil: retainUntil}
+	}
+}
+
+// WithLegalHold places a legal hold on an object written by PutObject.
+func WithLegalHold() ObjectOption {
+	return func(opts *ObjectOptions) {
+		opts.set |= optLegalHold
+		opts.legalHold = true
+	}
+}
+
+// WithBypassGovernanceRetention lets DeleteObject and PutObjectRetention override GOVERNANCE retention.
+// It has no effect on COMPLIANCE retention or legal holds.
+func WithBypassGovernanceRetention() ObjectOption {
+	return func(opts *ObjectOptions) {
+		opts.set |= optBypassGovernance
+		opts.bypassGovernance = true
+	}
+}
+
+// validateRetentionMode checks that mode is a known retention mode.
+func validateRetentionMode(mode string) error {
+	switch mode {
+	case RetentionGovernance, RetentionCompliance:
+		return nil
+	default:
+		return fmt.Errorf("invalid retention mode %q: must be %q or %q", mode, RetentionGovernance, RetentionCompliance)
+	}
+}
+
+// validate checks an object retention before it is sent to the service.
+func (retention *ObjectRetention) validate() error {
+	if err := validateRetentionMode(retention.Mode); err != nil {
+		return err
+	}
+	if !retention.RetainUntil.After(time.Now()) {
+		return fmt.Errorf("retention date %s is not in the future", retention.RetainUntil.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// validate checks an object lock configuration before it is sent to the service.
+func (config *ObjectLockConfiguration) validate() error {
+	if config.DefaultRetention == nil {
+		return nil
+	}
+	if !config.Enabled {
+		return fmt.Errorf("default retention requires object lock to be enabled")
+	}
+	retention := config.DefaultRetention
+	if err := validateRetentionMode(retention.Mode); err != nil {
+		return err
+	}
+	if retention.Days < 0 || retention.Years < 0 {
+		return fmt.Errorf("default retention period must be positive")
+	}
+	if (retention.Days > 0) == (retention.Years > 0) {
+		return fmt.Errorf("default retention must specify exactly one of days and years")
+	}
+	return nil
+}
+
+// objectLockedError marks err as ErrObjectLocked if the service reports that object lock blocked the operation.
+func objectLockedError(err error) error {
+	if status.Code(err) == codes.FailedPrecondition {
+		return fmt.Errorf("%w: %w", ErrObjectLocked, err)
+	}
+	return err
+}
+
+// PutObjectLockConfiguration sets the object lock configuration of a bucket.
+// Once enabled, object lock cannot be disabled; the default retention can still be changed or removed.
+// It returns an error if validation or the operation fails.
+func (client *ACSClient) PutObjectLockConfiguration(ctx context.Context, bucket string, config ObjectLockConfiguration) error {
+	if err := config.validate(); err != nil {
+		return err
+	}
+
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		req := &pb.PutObjectLockConfigurationRequest{
+			Bucket:  bucket,
+			Enabled: config.Enabled,
+		}
+		if retention := config.DefaultRetention; retention != nil {
+			req.DefaultRetention = &pb.ObjectLockRetentionRule{
+				Mode:  retention.Mode,
+				Days:  retention.Days,
+				Years: retention.Years,
+			}
+		}
+
+		_, err := client.client.PutObjectLockConfiguration(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to put object lock configuration: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetObjectLockConfiguration retrieves the object lock configuration of a bucket.
+// It returns the configuration and an error if the operation fails.
+func (client *ACSClient) GetObjectLockConfiguration(ctx context.Context, bucket string) (*ObjectLockConfiguration, error) {
+	return withRetry(ctx, client.retry, func(ctx context.Context) (*ObjectLockConfiguration, error) {
+		req := &pb.GetObjectLockConfigurationRequest{
+			Bucket: bucket,
+		}
+
+		resp, err := client.client.GetObjectLockConfiguration(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get object lock configuration: %w", err)
+		}
+
+		config := &ObjectLockConfiguration{Enabled: resp.Enabled}
+		if retention := resp.DefaultRetention; retention != nil {
+			config.DefaultRetention = &DefaultRetention{
+				Mode:  retention.Mode,
+				Days:  retention.Days,
+				Years: retention.Years,
+			}
+		}
+		return config, nil
+	})
+}
+
+// PutObjectRetention sets the retention of an object, or of a specific version with WithVersionID.
+// Retention can always be extended; shortening or removing GOVERNANCE retention requires
+// WithBypassGovernanceRetention, and COMPLIANCE retention cannot be shortened at all.
+// It returns an error wrapping ErrObjectLocked if the existing retention blocks the change.
+func (client *ACSClient) PutObjectRetention(ctx context.Context, bucket, key string, retention ObjectRetention, options ...ObjectOption) error {
+	if err := retention.validate(); err != nil {
+		return err
+	}
+	opts, err := applyObjectOptions("PutObjectRetention", optVersionID|optBypassGovernance, options)
+	if err != nil {
+		return err
+	}
+
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		req := &pb.PutObjectRetentionRequest{
+			Bucket:      bucket,
+			Key:         key,
+			Mode:        retention.Mode,
+			RetainUntil: timestamppb.New(retention.RetainUntil),
+		}
+		if opts.versionID != "" {
+			req.VersionId = &opts.versionID
+		}
+		if opts.bypassGovernance {
+			req.BypassGovernanceRetention = &opts.bypassGovernance
+		}
+
+		_, err := client.client.PutObjectRetention(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to put object retention: %w", objectLockedError(err))
+		}
+
+		return nil
+	})
+}
+
+// GetObjectRetention retrieves the retention of an object, or of a specific version with WithVersionID.
+// It returns nil if the object has no retention, and an error if the operation fails.
+func (client *ACSClient) GetObjectRetention(ctx context.Context, bucket, key string, options ...ObjectOption) (*ObjectRetention, error) {
+	opts, err := applyObjectOptions("GetObjectRetention", optVersionID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return withRetry(ctx, client.retry, func(ctx context.Context) (*ObjectRetention, error) {
+		req := &pb.GetObjectRetentionRequest{
+			Bucket: bucket,
+			Key:    key,
+		}
+		if opts.versionID != "" {
+			req.VersionId = &opts.versionID
+		}
+
+		resp, err := client.client.GetObjectRetention(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get object retention: %w", err)
+		}
+
+		if resp.Mode == "" {
+			return nil, nil
+		}
+		return &ObjectRetention{
+			Mode:        resp.Mode,
+			RetainUntil: resp.RetainUntil.AsTime(),
+		}, nil
+	})
+}
+
+// PutObjectLegalHold places or removes a legal hold on an object, or on a specific version with WithVersionID.
+// A legal hold blocks deletion independently of retention until it is removed.
+// It returns an error if the operation fails.
+func (client *ACSClient) PutObjectLegalHold(ctx context.Context, bucket, key string, on bool, options ...ObjectOption) error {
+	opts, err := applyObjectOptions("PutObjectLegalHold", optVersionID, options)
+	if err != nil {
+		return err
+	}
+
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		req := &pb.PutObjectLegalHoldRequest{
+			Bucket: bucket,
+			Key:    key,
+			On:     on,
+		}
+		if opts.versionID != "" {
+			req.VersionId = &opts.versionID
+		}
+
+		_, err := client.client.PutObjectLegalHold(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to put object legal hold: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetObjectLegalHold reports whether an object, or a specific version with WithVersionID, is under legal hold.
+// It returns an error if the operation fails.
+func (client *ACSClient) GetObjectLegalHold(ctx context.Context, bucket, key string, options ...ObjectOption) (bool, error) {
+	opts, err := applyObjectOptions("GetObjectLegalHold", optVersionID, options)
+	if err != nil {
+		return false, err
+	}
+
+	return withRetry(ctx, client.retry, func(ctx context.Context) (bool, error) {
+		req := &pb.GetObjectLegalHoldRequest{
+			Bucket: bucket,
+			Key:    key,
+		}
+		if opts.versionID != "" {
+			req.VersionId = &opts.versionID
+		}
+
+		resp, err := client.client.GetObjectLegalHold(ctx, req)
+		if err != nil {
+			return false, fmt.Errorf("failed to get object legal hold: %w", err)
+		}
+
+		return resp.On, nil
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestObjectLockConfigurationValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  ObjectLockConfiguration
+		wantErr string
+	}{
+		{name: "enabled", config: ObjectLockConfiguration{Enabled: true}},
+		{name: "disabled", config: ObjectLockConfiguration{}},
+		{name: "days", config: ObjectLockConfiguration{Enabled: true, DefaultRetention: &DefaultRetention{Mode: RetentionGovernance, Days: 30}}},
+		{name: "years", config: ObjectLockConfiguration{Enabled: true, DefaultRetention: &DefaultRetention{Mode: RetentionCompliance, Years: 7}}},
+		{name: "retention without lock", config: ObjectLockConfiguration{DefaultRetention: &DefaultRetention{Mode: RetentionGovernance, Days: 30}}, wantErr: "requires object lock to be enabled"},
+		{name: "mode", config: ObjectLockConfiguration{Enabled: true, DefaultRetention: &DefaultRetention{Mode: "governance", Days: 30}}, wantErr: "invalid retention mode"},
+		{name: "negative", config: ObjectLockConfiguration{Enabled: true, DefaultRetention: &DefaultRetention{Mode: RetentionGovernance, Days: -1}}, wantErr: "must be positive"},
+		{name: "no period", config: ObjectLockConfiguration{Enabled: true, DefaultRetention: &DefaultRetention{Mode: RetentionGovernance}}, wantErr: "exactly one of days and years"},
+		{name: "both periods", config: ObjectLockConfiguration{Enabled: true, DefaultRetention: &DefaultRetention{Mode: RetentionGovernance, Days: 1, Years: 1}}, wantErr: "exactly one of days and years"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validate() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestObjectRetentionValidate(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	tests := []struct {
+		name      string
+		retention ObjectRetention
+		wantErr   string
+	}{
+		{name: "governance", retention: ObjectRetention{Mode: RetentionGovernance, RetainUntil: future}},
+		{name: "compliance", retention: ObjectRetention{Mode: RetentionCompliance, RetainUntil: future}},
+		{name: "mode", retention: ObjectRetention{Mode: "LEGAL", RetainUntil: future}, wantErr: "invalid retention mode"},
+		{name: "past", retention: ObjectRetention{Mode: RetentionGovernance, RetainUntil: time.Now().Add(-time.Hour)}, wantErr: "not in the future"},
+		{name: "unset", retention: ObjectRetention{Mode: RetentionGovernance}, wantErr: "not in the future"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.retention.validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validate() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestObjectLockValidatesLocally(t *testing.T) {
+	storage := newFakeStorage()
+	client := newTestClient(t, storage)
+	ctx := context.Background()
+	past := time.Now().Add(-time.Hour)
+
+	// The fake does not implement object lock, so only a local error names the problem
+	if err := client.PutObjectRetention(ctx, "bucket", "object", ObjectRetention{Mode: RetentionGovernance, RetainUntil: past}); err == nil || !strings.Contains(err.Error(), "not in the future") {
+		t.Errorf("PutObjectRetention() error = %v, want the past date reported", err)
+	}
+	if err := client.PutObjectLockConfiguration(ctx, "bucket", ObjectLockConfiguration{DefaultRetention: &DefaultRetention{Mode: RetentionGovernance, Days: 1}}); err == nil || !strings.Contains(err.Error(), "requires object lock") {
+		t.Errorf("PutObjectLockConfiguration() error = %v, want the disabled lock reported", err)
+	}
+	if err := client.PutObjectLegalHold(ctx, "bucket", "object", true, WithBypassGovernanceRetention()); err == nil || !strings.Contains(err.Error(), "does not support") {
+		t.Errorf("PutObjectLegalHold() with a bypass error = %v, want the option rejected", err)
+	}
+
+	if err := client.PutObject(ctx, "bucket", "object", []byte("data"), WithRetention("LEGAL", time.Now().Add(time.Hour))); err == nil || !strings.Contains(err.Error(), "invalid retention mode") {
+		t.Errorf("PutObject() with an invalid retention error = %v, want the mode reported", err)
+	}
+	if got := storage.called("PutObject"); got != 0 {
+		t.Errorf("PutObject calls = %d, want none", got)
+	}
+}
+
+func TestDeleteObjectLocked(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("object", []byte("data"))
+	locked := grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if info.FullMethod == pb.ObjectStorageCache_DeleteObject_FullMethodName {
+			return nil, status.Error(codes.FailedPrecondition, "object is under legal hold")
+		}
+		return handler(ctx, req)
+	})
+	client := newTestClientWith(t, storage, []grpc.ServerOption{locked})
+
+	err := client.DeleteObject(context.Background(), "bucket", "object")
+	if !errors.Is(err, ErrObjectLocked) || status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("DeleteObject() of a locked object error = %v, want ErrObjectLocked wrapping FailedPrecondition", err)
+	}
+
+	if err := objectLockedError(status.Error(codes.NotFound, "no such key")); errors.Is(err, ErrObjectLocked) {
+		t.Errorf("objectLockedError() of NotFound = %v, want it left alone", err)
+	}
+}
@@ -0,0 +1,436 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+)
+
+// Policy constants
+const (
+	// PolicyVersion is the policy language version understood by the service
+	PolicyVersion = "2012-10-17"
+
+	// PolicyEffectAllow grants the actions of a statement
+	PolicyEffectAllow = "Allow"
+	// PolicyEffectDeny denies the actions of a statement, overriding any Allow
+	PolicyEffectDeny = "Deny"
+
+	// policyResourcePrefix is the prefix of every bucket and object resource
+	policyResourcePrefix = "arn:acs:s3:::"
+	// policyActionPrefix is the prefix of every action name
+	policyActionPrefix = "acs:"
+	// maxPolicySize is the maximum size of an encoded policy document
+	maxPolicySize = 20 * 1024
+)
+
+// policyActions lists the action names that may appear in a statement (after the "acs:" prefix).
+var policyActions = map[string]bool{
+	"CreateBucket":        true,
+	"DeleteBucket":        true,
+	"ListBucket":          true,
+	"ListBucketVersions":  true,
+	"GetBucketVersioning": true,
+	"PutBucketVersioning": true,
+	"GetLifecycle":        true,
+	"PutLifecycle":        true,
+	"GetBucketPolicy":     true,
+	"PutBucketPolicy":     true,
+	"DeleteBucketPolicy":  true,
+	"GetObject":           true,
+	"GetObjectVersion":    true,
+	"PutObject":           true,
+	"DeleteObject":        true,
+	"DeleteObjectVersion": true,
+	"GetObjectTagging":    true,
+	"PutObjectTagging":    true,
+	"DeleteObjectTagging": true,
+
+	"GetObjectLockConfiguration": true,
+	"PutObjectLockConfiguration": true,
+	"GetObjectRetention":         true,
+	"PutObjectRetention":         true,
+	"GetObjectLegalHold":         true,
+	"PutObjectLegalHold":         true,
+	"BypassGovernanceRetention":  true,
+
+	"GetBucketEncryption":    true,
+	"PutBucketEncryption":    true,
+	"DeleteBucketEncryption": true,
+
+	"SelectObjectContent": true,
+}
+
+// policyConditionOperators lists the condition operators a statement may use.
+// Each may also be suffixed with "IfExists".
+var policyConditionOperators = map[string]bool{
+	"StringEquals":              true,
+	"StringNotEquals":           true,
+	"StringEqualsIgnoreCase":    true,
+	"StringNotEqualsIgnoreCase": true,
+	"StringLike":                true,
+	"StringNotLike":             true,
+	"NumericEquals":             true,
+	"NumericNotEquals":          true,
+	"NumericLessThan":           true,
+	"NumericLessThanEquals":     true,
+	"NumericGreaterThan":        true,
+	"NumericGreaterThanEquals":  true,
+	"DateEquals":                true,
+	"DateNotEquals":             true,
+	"DateLessThan":              true,
+	"DateLessThanEquals":        true,
+	"DateGreaterThan":           true,
+	"DateGreaterThanEquals":     true,
+	"Bool":                      true,
+	"IpAddress":                 true,
+	"NotIpAddress":              true,
+	"Null":                      true,
+}
+
+// BucketPolicy is an access policy document attached to a bucket.
+// It can be built programmatically and is validated locally by PutBucketPolicy.
+type BucketPolicy struct {
+	// Version is the policy language version (PolicyVersion)
+	Version string `json:"Version"`
+	// ID optionally identifies the policy
+	ID string `json:"Id,omitempty"`
+	// Statement lists the statements of the policy
+	Statement []PolicyStatement `json:"Statement"`
+}
+
+// PolicyStatement grants or denies a set of actions on a set of resources to a set of principals.
+type PolicyStatement struct {
+	// Sid optionally identifies the statement
+	Sid string `json:"Sid,omitempty"`
+	// Effect is PolicyEffectAllow or PolicyEffectDeny
+	Effect string `json:"Effect"`
+	// Principal selects who the statement applies to
+	Principal PolicyPrincipal `json:"Principal"`
+	// Action lists the actions, e.g. "acs:GetObject" or "acs:*"
+	Action StringList `json:"Action"`
+	// Resource lists the bucket and object resources, see BucketResource and ObjectResource
+	Resource StringList `json:"Resource"`
+	// Condition restricts when the statement applies, keyed by operator and then by condition key
+	Condition PolicyConditions `json:"Condition,omitempty"`
+}
+
+// PolicyPrincipal identifies the principals a statement applies to.
+// It is encoded as "*" when Any is set and as {"ACS": [...]} otherwise.
+type PolicyPrincipal struct {
+	// Any matches every principal, including anonymous requests
+	Any bool
+	// ACS lists ACS account IDs or access key IDs
+	ACS StringList
+}
+
+// PolicyConditions maps a condition operator (e.g. "StringLike") to condition keys and their values.
+type PolicyConditions map[string]map[string]StringList
+
+// StringList is a list of strings that also accepts a single string when decoded from JSON.
+type StringList []string
+
+// NewBucketPolicy returns a policy document with the current version and the given statements.
+func NewBucketPolicy(statements ...PolicyStatement) *BucketPolicy {
+	return &BucketPolicy{
+		Version:   PolicyVersion,
+		Statement: statements,
+	}
+}
+
+// AnyPrincipal returns a principal matching everyone.
+func AnyPrincipal() PolicyPrincipal {
+	return PolicyPrincipal{Any: true}
+}
+
+// AccountPrincipal returns a principal matching the given ACS account or access key IDs.
+func AccountPrincipal(ids ...string) PolicyPrincipal {
+	return PolicyPrincipal{ACS: ids}
+}
+
+// BucketResource returns the resource name of a bucket, used by bucket-level actions such as acs:ListBucket.
+func BucketResource(bucket string) string {
+	return policyResourcePrefix + bucket
+}
+
+// ObjectResource returns the resource name of the objects in a bucket matching keyPattern,
+// which may contain "*" and "?" wildcards.
+func ObjectResource(bucket, keyPattern string) string {
+	return policyResourcePrefix + bucket + "/" + keyPattern
+}
+
+// MarshalJSON encodes the principal as "*" or {"ACS": [...]}.
+func (p PolicyPrincipal) MarshalJSON() ([]byte, error) {
+	if p.Any {
+		return json.Marshal("*")
+	}
+	return json.Marshal(map[string]StringList{"ACS": p.ACS})
+}
+
+// UnmarshalJSON decodes a principal from "*" or {"ACS": ...}.
+func (p *PolicyPrincipal) UnmarshalJSON(data []byte) error {
+	var wildcard string
+	if err := json.Unmarshal(data, &wildcard); err == nil {
+		if wildcard != "*" {
+			return fmt.Errorf("invalid principal %q", wildcard)
+		}
+		*p = PolicyPrincipal{Any: true}
+		return nil
+	}
+
+	var principals map[string]StringList
+	if err := json.Unmarshal(data, &principals); err != nil {
+		return fmt.Errorf("invalid principal: %v", err)
+	}
+	*p = PolicyPrincipal{}
+	for kind, ids := range principals {
+		if kind != "ACS" {
+			return fmt.Errorf("unsupported principal type %q", kind)
+		}
+		p.ACS = ids
+	}
+	if len(p.ACS) == 1 && p.ACS[0] == "*" {
+		*p = PolicyPrincipal{Any: true}
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes either a single string or a list of strings.
+func (l *StringList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*l = StringList{single}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("expected a string or a list of strings: %v", err)
+	}
+	*l = list
+	return nil
+}
+
+// Validate checks the policy for the given bucket: the version, effects, principals, actions,
+// resources (which must belong to the bucket) and condition operators.
+func (policy *BucketPolicy) Validate(bucket string) error {
+	if policy == nil {
+		return fmt.Errorf("policy must not be nil")
+	}
+	if policy.Version != PolicyVersion {
+		return fmt.Errorf("unsupported policy version %q, expected %q", policy.Version, PolicyVersion)
+	}
+	if len(policy.Statement) == 0 {
+		return fmt.Errorf("policy must contain at least one statement")
+	}
+
+	sids := make(map[string]bool)
+	for i, statement := range policy.Statement {
+		name := fmt.Sprintf("statement %d", i)
+		if statement.Sid != "" {
+			name = fmt.Sprintf("statement %q", statement.Sid)
+			if sids[statement.Sid] {
+				return fmt.Errorf("%s: duplicate Sid", name)
+			}
+			sids[statement.Sid] = true
+		}
+
+		if err := statement.validate(bucket); err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+	}
+
+	return nil
+}
+
+// validate checks a single statement.
+func (statement *PolicyStatement) validate(bucket string) error {
+	if statement.Effect != PolicyEffectAllow && statement.Effect != PolicyEffectDeny {
+		return fmt.Errorf("effect must be %q or %q", PolicyEffectAllow, PolicyEffectDeny)
+	}
+
+	if !statement.Principal.Any {
+		if len(statement.Principal.ACS) == 0 {
+			return fmt.Errorf("principal must not be empty")
+		}
+		for _, id := range statement.Principal.ACS {
+			if id == "" {
+				return fmt.Errorf("principal IDs must not be empty")
+			}
+		}
+	}
+
+	if len(statement.Action) == 0 {
+		return fmt.Errorf("at least one action is required")
+	}
+	for _, action := range statement.Action {
+		if err := validatePolicyAction(action); err != nil {
+			return err
+		}
+	}
+
+	if len(statement.Resource) == 0 {
+		return fmt.Errorf("at least one resource is required")
+	}
+	for _, resource := range statement.Resource {
+		name, ok := strings.CutPrefix(resource, policyResourcePrefix)
+		if !ok {
+			return fmt.Errorf("resource %q must start with %q", resource, policyResourcePrefix)
+		}
+		if resourceBucket, _, _ := strings.Cut(name, "/"); resourceBucket != bucket {
+			return fmt.Errorf("resource %q does not belong to bucket %q", resource, bucket)
+		}
+	}
+
+	for operator, conditions := range statement.Condition {
+		if !policyConditionOperators[strings.TrimSuffix(operator, "IfExists")] {
+			return fmt.Errorf("unknown condition operator %q", operator)
+		}
+		if len(conditions) == 0 {
+			return fmt.Errorf("condition operator %q has no keys", operator)
+		}
+		for key, values := range conditions {
+			if key == "" {
+				return fmt.Errorf("condition operator %q has an empty key", operator)
+			}
+			if len(values) == 0 {
+				return fmt.Errorf("condition %s %q has no values", operator, key)
+			}
+			if strings.HasSuffix(strings.TrimSuffix(operator, "IfExists"), "IpAddress") {
+				for _, value := range values {
+					if _, _, err := net.ParseCIDR(value); err != nil && net.ParseIP(value) == nil {
+						return fmt.Errorf("condition %s %q: invalid IP address or CIDR %q", operator, key, value)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// validatePolicyAction checks that an action is "*" or an "acs:" action, allowing wildcards in the name.
+func validatePolicyAction(action string) error {
+	if action == "*" {
+		return nil
+	}
+
+	name, ok := strings.CutPrefix(action, policyActionPrefix)
+	if !ok || name == "" {
+		return fmt.Errorf("action %q must start with %q", action, policyActionPrefix)
+	}
+	if strings.ContainsAny(name, "*?") {
+		// A wildcard must match at least one known action
+		for known := range policyActions {
+			if matchWildcard(name, known) {
+				return nil
+			}
+		}
+		return fmt.Errorf("action %q does not match any known action", action)
+	}
+	if !policyActions[name] {
+		return fmt.Errorf("unknown action %q", action)
+	}
+	return nil
+}
+
+// matchWildcard reports whether s matches pattern, where "*" matches any sequence and "?" any single character.
+func matchWildcard(pattern, s string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for i := len(s); i >= 0; i-- {
+				if matchWildcard(pattern[1:], s[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(s) == 0 {
+				return false
+			}
+		default:
+			if len(s) == 0 || s[0] != pattern[0] {
+				return false
+			}
+		}
+		pattern, s = pattern[1:], s[1:]
+	}
+	return len(s) == 0
+}
+
+// PutBucketPolicy replaces the access policy of a bucket.
+// The policy is validated locally before it is sent.
+// It returns an error if validation or the operation fails.
+func (client *ACSClient) PutBucketPolicy(ctx context.Context, bucket string, policy *BucketPolicy) error {
+	if err := policy.Validate(bucket); err != nil {
+		return fmt.Errorf("invalid bucket policy: %v", err)
+	}
+
+	document, err := json.Marshal(policy)
+	if err != nil {
+		return fmt.Errorf("failed to marshal bucket policy: %v", err)
+	}
+	if len(document) > maxPolicySize {
+		return fmt.Errorf("bucket policy is %d bytes, maximum is %d", len(document), maxPolicySize)
+	}
+
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		req := &pb.PutBucketPolicyRequest{
+			Bucket: bucket,
+			Policy: string(document),
+		}
+
+		_, err := client.client.PutBucketPolicy(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to put bucket policy: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetBucketPolicy retrieves the access policy of a bucket.
+// It returns the decoded policy and an error if the operation fails.
+func (client *ACSClient) GetBucketPolicy(ctx context.Context, bucket string) (*BucketPolicy, error) {
+	return withRetry(ctx, client.retry, func(ctx context.Context) (*BucketPolicy, error) {
+		req := &pb.GetBucketPolicyRequest{
+			Bucket: bucket,
+		}
+
+		resp, err := client.client.GetBucketPolicy(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bucket policy: %w", err)
+		}
+
+		var policy BucketPolicy
+		if err := json.Unmarshal([]byte(resp.Policy), &policy); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal bucket policy: %v", err)
+		}
+
+		return &policy, nil
+	})
+}
+
+// DeleteBucketPolicy removes the access policy of a bucket.
+// It returns an error if the operation fails.
+func (client *ACSClient) DeleteBucketPolicy(ctx context.Context, bucket string) error {
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		req := &pb.DeleteBucketPolicyRequest{
+			Bucket: bucket,
+		}
+
+		_, err := client.client.DeleteBucketPolicy(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to delete bucket policy: %w", err)
+		}
+
+		return nil
+	})
+}
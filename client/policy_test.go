@@ -0,0 +1,174 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// actionPolicy returns a policy for bucket granting actions on all of its objects.
+func actionPolicy(actions ...string) *BucketPolicy {
+	return NewBucketPolicy(PolicyStatement{
+		Effect:    PolicyEffectAllow,
+		Principal: AnyPrincipal(),
+		Action:    actions,
+		Resource:  StringList{ObjectResource("bucket", "*")},
+	})
+}
+
+func TestPolicyValidate(t *testing.T) {
+	// statement returns a valid statement changed by modify
+	statement := func(modify func(*PolicyStatement)) PolicyStatement {
+		s := PolicyStatement{
+			Effect:    PolicyEffectAllow,
+			Principal: AccountPrincipal("123456789012"),
+			Action:    StringList{"acs:GetObject", "acs:List*"},
+			Resource:  StringList{BucketResource("bucket"), ObjectResource("bucket", "logs/*")},
+			Condition: PolicyConditions{
+				"IpAddressIfExists": {"acs:SourceIp": {"10.0.0.0/8", "192.168.1.1"}},
+				"StringLike":        {"acs:prefix": {"logs/"}},
+			},
+		}
+		if modify != nil {
+			modify(&s)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name    string
+		policy  *BucketPolicy
+		wantErr string
+	}{
+		{name: "valid", policy: NewBucketPolicy(statement(nil), statement(func(s *PolicyStatement) {
+			s.Effect, s.Principal, s.Action = PolicyEffectDeny, AnyPrincipal(), StringList{"*"}
+		}))},
+		{name: "nil", wantErr: "must not be nil"},
+		{name: "version", policy: &BucketPolicy{Version: "2008-10-17", Statement: []PolicyStatement{statement(nil)}}, wantErr: "unsupported policy version"},
+		{name: "no statements", policy: NewBucketPolicy(), wantErr: "at least one statement"},
+		{name: "duplicate Sid", policy: NewBucketPolicy(
+			statement(func(s *PolicyStatement) { s.Sid = "read" }),
+			statement(func(s *PolicyStatement) { s.Sid = "read" }),
+		), wantErr: `statement "read": duplicate Sid`},
+		{name: "effect", policy: NewBucketPolicy(statement(func(s *PolicyStatement) { s.Effect = "Permit" })), wantErr: "effect must be"},
+		{name: "no principal", policy: NewBucketPolicy(statement(func(s *PolicyStatement) { s.Principal = PolicyPrincipal{} })), wantErr: "principal must not be empty"},
+		{name: "empty principal ID", policy: NewBucketPolicy(statement(func(s *PolicyStatement) { s.Principal = AccountPrincipal("") })), wantErr: "principal IDs must not be empty"},
+		{name: "no actions", policy: NewBucketPolicy(statement(func(s *PolicyStatement) { s.Action = nil })), wantErr: "at least one action"},
+		{name: "action prefix", policy: NewBucketPolicy(statement(func(s *PolicyStatement) { s.Action = StringList{"s3:GetObject"} })), wantErr: `must start with "acs:"`},
+		{name: "unknown action", policy: NewBucketPolicy(statement(func(s *PolicyStatement) { s.Action = StringList{"acs:GetObjects"} })), wantErr: "unknown action"},
+		{name: "unmatched wildcard", policy: NewBucketPolicy(statement(func(s *PolicyStatement) { s.Action = StringList{"acs:Frobnicate*"} })), wantErr: "does not match any known action"},
+		{name: "no resources", policy: NewBucketPolicy(statement(func(s *PolicyStatement) { s.Resource = nil })), wantErr: "at least one resource"},
+		{name: "resource prefix", policy: NewBucketPolicy(statement(func(s *PolicyStatement) { s.Resource = StringList{"bucket/*"} })), wantErr: "must start with"},
+		{name: "other bucket", policy: NewBucketPolicy(statement(func(s *PolicyStatement) { s.Resource = StringList{ObjectResource("other", "*")} })), wantErr: `does not belong to bucket "bucket"`},
+		{name: "bucket name prefix", policy: NewBucketPolicy(statement(func(s *PolicyStatement) { s.Resource = StringList{BucketResource("bucket-2")} })), wantErr: "does not belong"},
+		{name: "unknown operator", policy: NewBucketPolicy(statement(func(s *PolicyStatement) {
+			s.Condition = PolicyConditions{"StringMatches": {"acs:prefix": {"logs/"}}}
+		})), wantErr: "unknown condition operator"},
+		{name: "operator without keys", policy: NewBucketPolicy(statement(func(s *PolicyStatement) {
+			s.Condition = PolicyConditions{"StringLike": {}}
+		})), wantErr: "has no keys"},
+		{name: "empty condition key", policy: NewBucketPolicy(statement(func(s *PolicyStatement) {
+			s.Condition = PolicyConditions{"StringLike": {"": {"logs/"}}}
+		})), wantErr: "empty key"},
+		{name: "condition without values", policy: NewBucketPolicy(statement(func(s *PolicyStatement) {
+			s.Condition = PolicyConditions{"StringLike": {"acs:prefix": {}}}
+		})), wantErr: "has no values"},
+		{name: "invalid IP", policy: NewBucketPolicy(statement(func(s *PolicyStatement) {
+			s.Condition = PolicyConditions{"NotIpAddress": {"acs:SourceIp": {"10.0.0.0/33"}}}
+		})), wantErr: "invalid IP address or CIDR"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate("bucket")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPolicyJSON(t *testing.T) {
+	// Single strings and wildcard principals in either form are accepted when decoding
+	document := `{
+		"Version": "2012-10-17",
+		"Statement": [
+			{"Effect": "Allow", "Principal": "*", "Action": "acs:GetObject", "Resource": "arn:acs:s3:::bucket/*"},
+			{"Effect": "Allow", "Principal": {"ACS": "*"}, "Action": ["acs:ListBucket"], "Resource": ["arn:acs:s3:::bucket"]},
+			{"Effect": "Deny", "Principal": {"ACS": ["a", "b"]}, "Action": "acs:*", "Resource": "arn:acs:s3:::bucket/*"}
+		]
+	}`
+	var policy BucketPolicy
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	want := NewBucketPolicy(
+		PolicyStatement{Effect: PolicyEffectAllow, Principal: AnyPrincipal(), Action: StringList{"acs:GetObject"}, Resource: StringList{ObjectResource("bucket", "*")}},
+		PolicyStatement{Effect: PolicyEffectAllow, Principal: AnyPrincipal(), Action: StringList{"acs:ListBucket"}, Resource: StringList{BucketResource("bucket")}},
+		PolicyStatement{Effect: PolicyEffectDeny, Principal: AccountPrincipal("a", "b"), Action: StringList{"acs:*"}, Resource: StringList{ObjectResource("bucket", "*")}},
+	)
+	if !reflect.DeepEqual(&policy, want) {
+		t.Fatalf("Unmarshal() = %+v, want %+v", policy, want)
+	}
+
+	// Encoding and decoding again gives back the same policy
+	data, err := json.Marshal(&policy)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var decoded BucketPolicy
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() of %s error = %v", data, err)
+	}
+	if !reflect.DeepEqual(&decoded, want) {
+		t.Errorf("round trip = %+v, want %+v", decoded, want)
+	}
+
+	for _, principal := range []string{`"someone"`, `{"AWS": ["a"]}`} {
+		var p PolicyPrincipal
+		if err := json.Unmarshal([]byte(principal), &p); err == nil {
+			t.Errorf("Unmarshal() of principal %s succeeded, want an error", principal)
+		}
+	}
+}
+
+func TestPolicyObjectLockActions(t *testing.T) {
+	policy := actionPolicy(
+		"acs:GetObjectLockConfiguration", "acs:PutObjectLockConfiguration",
+		"acs:GetObjectRetention", "acs:PutObjectRetention",
+		"acs:GetObjectLegalHold", "acs:PutObjectLegalHold",
+		"acs:BypassGovernanceRetention",
+	)
+	if err := policy.Validate("bucket"); err != nil {
+		t.Errorf("Validate() of object lock actions error = %v", err)
+	}
+	if err := actionPolicy("acs:*LegalHold").Validate("bucket"); err != nil {
+		t.Errorf("Validate() of a legal hold wildcard error = %v", err)
+	}
+}
+
+func TestPolicyEncryptionActions(t *testing.T) {
+	policy := actionPolicy("acs:GetBucketEncryption", "acs:PutBucketEncryption", "acs:DeleteBucketEncryption")
+	if err := policy.Validate("bucket"); err != nil {
+		t.Errorf("Validate() of bucket encryption actions error = %v", err)
+	}
+	if err := actionPolicy("acs:*BucketEncryption").Validate("bucket"); err != nil {
+		t.Errorf("Validate() of a bucket encryption wildcard error = %v", err)
+	}
+}
+
+func TestPolicySelectAction(t *testing.T) {
+	if err := actionPolicy("acs:SelectObjectContent").Validate("bucket"); err != nil {
+		t.Errorf("Validate() of the select action error = %v", err)
+	}
+	if err := actionPolicy("acs:Select*").Validate("bucket"); err != nil {
+		t.Errorf("Validate() of a select wildcard error = %v", err)
+	}
+}
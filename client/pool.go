@@ -0,0 +1,227 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+)
+
+// Channel pool policies
+const (
+	// PoolRoundRobin sends each request to the next channel in turn
+	PoolRoundRobin = "round-robin"
+	// PoolLeastLoaded sends each request to the channel with the fewest requests in flight
+	PoolLeastLoaded = "least-loaded"
+
+	minWindowSize = 64 * 1024 // Smallest HTTP/2 window accepted by gRPC
+)
+
+// ChannelPoolConfig configures the pool of gRPC channels a client spreads its requests over.
+// Each channel is a separate HTTP/2 connection with its own flow-control window.
+type ChannelPoolConfig struct {
+	// Size is the number of channels (default 1)
+	Size int
+	// Policy is PoolRoundRobin (default) or PoolLeastLoaded
+	Policy string
+	// InitialWindowSize is the HTTP/2 flow-control window of each stream in bytes (minimum 64KB).
+	// Zero keeps gRPC's default dynamic window.
+	InitialWindowSize int32
+	// InitialConnWindowSize is the HTTP/2 flow-control window of each connection in bytes (minimum 64KB).
+	// Zero keeps gRPC's default dynamic window.
+	InitialConnWindowSize int32
+}
+
+// ChannelStats reports the load of one channel in the pool.
+type ChannelStats struct {
+	// Index is the position of the channel in the pool
+	Index int
+	// State is the connectivity state of the channel, such as "READY" or "IDLE"
+	State string
+	// InFlight is the number of requests currently using the channel
+	InFlight int64
+	// Requests is the total number of requests sent on the channel
+	Requests uint64
+}
+
+// validate checks the configuration and applies defaults.
+func (config *ChannelPoolConfig) validate() error {
+	if config.Size <= 0 {
+		config.Size = 1
+	}
+	switch config.Policy {
+	case "":
+		config.Policy = PoolRoundRobin
+	case PoolRoundRobin, PoolLeastLoaded:
+	default:
+		return fmt.Errorf("invalid pool policy %q: must be %q or %q", config.Policy, PoolRoundRobin, PoolLeastLoaded)
+	}
+	if config.InitialWindowSize != 0 && config.InitialWindowSize < minWindowSize {
+		return fmt.Errorf("initial window size must be at least %d bytes", minWindowSize)
+	}
+	if config.InitialConnWindowSize != 0 && config.InitialConnWindowSize < minWindowSize {
+		return fmt.Errorf("initial connection window size must be at least %d bytes", minWindowSize)
+	}
+	return nil
+}
+
+// dialOptions returns the dial options for the configured window sizes.
+func (config *ChannelPoolConfig) dialOptions() []grpc.DialOption {
+	var opts []grpc.DialOption
+	if config.InitialWindowSize != 0 {
+		opts = append(opts, grpc.WithInitialWindowSize(config.InitialWindowSize))
+	}
+	if config.InitialConnWindowSize != 0 {
+		opts = append(opts, grpc.WithInitialConnWindowSize(config.InitialConnWindowSize))
+	}
+	return opts
+}
+
+// pooledChannel is one connection of the pool with its load counters.
+type pooledChannel struct {
+	conn     *grpc.ClientConn
+	inFlight atomic.Int64
+	requests atomic.Uint64
+}
+
+// acquire records the start of a request on the channel.
+func (channel *pooledChannel) acquire() {
+	channel.inFlight.Add(1)
+	channel.requests.Add(1)
+}
+
+// release records the end of a request on the channel.
+func (channel *pooledChannel) release() {
+	channel.inFlight.Add(-1)
+}
+
+// channelPool spreads requests over several gRPC channels.
+// It implements grpc.ClientConnInterface so the generated client can use it in place of a single connection.
+type channelPool struct {
+	channels []*pooledChannel
+	policy   string
+	next     atomic.Uint64
+}
+
+var _ grpc.ClientConnInterface = (*channelPool)(nil)
+
+// newChannelPool opens config.Size channels to target.
+func newChannelPool(target string, config ChannelPoolConfig, opts ...grpc.DialOption) (*channelPool, error) {
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	opts = append(opts, config.dialOptions()...)
+
+	pool := &channelPool{policy: config.Policy}
+	for i := 0; i < config.Size; i++ {
+		conn, err := grpc.NewClient(target, opts...)
+		if err != nil {
+			pool.Close()
+			return nil, err
+		}
+		pool.channels = append(pool.channels, &pooledChannel{conn: conn})
+	}
+	return pool, nil
+}
+
+// pick selects the channel for the next request according to the pool policy.
+func (pool *channelPool) pick() *pooledChannel {
+	if len(pool.channels) == 1 {
+		return pool.channels[0]
+	}
+
+	if pool.policy == PoolLeastLoaded {
+		// Start at a rotating offset so ties are spread over the channels
+		start := int(pool.next.Add(1) % uint64(len(pool.channels)))
+		best := pool.channels[start]
+		for i := 1; i < len(pool.channels); i++ {
+			channel := pool.channels[(start+i)%len(pool.channels)]
+			if channel.inFlight.Load() < best.inFlight.Load() {
+				best = channel
+			}
+		}
+		return best
+	}
+
+	return pool.channels[pool.next.Add(1)%uint64(len(pool.channels))]
+}
+
+// Invoke performs a unary RPC on one of the channels.
+func (pool *channelPool) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	channel := pool.pick()
+	channel.acquire()
+	defer channel.release()
+	return channel.conn.Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream opens a stream on one of the channels.
+// The channel counts the stream as in flight until it ends or its context is done.
+func (pool *channelPool) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	channel := pool.pick()
+	channel.acquire()
+
+	stream, err := channel.conn.NewStream(ctx, desc, method, opts...)
+	if err != nil {
+		channel.release()
+		return nil, err
+	}
+
+	tracked := &trackedStream{ClientStream: stream, serverStreams: desc.ServerStreams}
+	tracked.release = func() { tracked.once.Do(channel.release) }
+	tracked.stop = context.AfterFunc(ctx, tracked.release)
+	return tracked, nil
+}
+
+// Close closes every channel.
+func (pool *channelPool) Close() error {
+	var firstErr error
+	for _, channel := range pool.channels {
+		if err := channel.conn.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// stats returns the load of every channel.
+func (pool *channelPool) stats() []ChannelStats {
+	stats := make([]ChannelStats, len(pool.channels))
+	for i, channel := range pool.channels {
+		stats[i] = ChannelStats{
+			Index:    i,
+			State:    channel.conn.GetState().String(),
+			InFlight: channel.inFlight.Load(),
+			Requests: channel.requests.Load(),
+		}
+	}
+	return stats
+}
+
+// trackedStream releases its channel once the stream has ended.
+type trackedStream struct {
+	grpc.ClientStream
+	serverStreams bool
+	once          sync.Once
+	release       func()
+	stop          func() bool
+}
+
+// RecvMsg receives a message. An error, including io.EOF, ends the stream, as does the single
+// response of a client-streaming RPC.
+func (stream *trackedStream) RecvMsg(m any) error {
+	err := stream.ClientStream.RecvMsg(m)
+	if err != nil || !stream.serverStreams {
+		stream.stop()
+		stream.release()
+	}
+	return err
+}
+
+// ChannelStats returns the current load of each channel in the client's pool.
+func (client *ACSClient) ChannelStats() []ChannelStats {
+	return client.pool.stats()
+}
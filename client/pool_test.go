@@ -0,0 +1,150 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestPool serves storage over an in-memory connection and opens a pool of channels to it.
+func newTestPool(t *testing.T, storage pb.ObjectStorageCacheServer, config ChannelPoolConfig) *channelPool {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	pb.RegisterObjectStorageCacheServer(server, storage)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	pool, err := newChannelPool("passthrough:///bufnet", config,
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("newChannelPool() error = %v", err)
+	}
+	t.Cleanup(func() { pool.Close() })
+	return pool
+}
+
+func TestChannelPoolConfigValidate(t *testing.T) {
+	config := ChannelPoolConfig{}
+	if err := config.validate(); err != nil {
+		t.Fatalf("validate() error = %v", err)
+	}
+	if config.Size != 1 || config.Policy != PoolRoundRobin {
+		t.Errorf("validate() defaults = %+v, want one round-robin channel", config)
+	}
+
+	tests := []struct {
+		name    string
+		config  ChannelPoolConfig
+		wantErr string
+	}{
+		{name: "policy", config: ChannelPoolConfig{Policy: "random"}, wantErr: "invalid pool policy"},
+		{name: "stream window", config: ChannelPoolConfig{InitialWindowSize: minWindowSize - 1}, wantErr: "initial window size"},
+		{name: "connection window", config: ChannelPoolConfig{InitialConnWindowSize: minWindowSize - 1}, wantErr: "initial connection window size"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.validate(); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validate() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestChannelPoolRoundRobin(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("object", []byte("data"))
+	pool := newTestPool(t, storage, ChannelPoolConfig{Size: 3})
+	client := pb.NewObjectStorageCacheClient(pool)
+
+	for i := 0; i < 6; i++ {
+		if _, err := client.HeadObject(context.Background(), &pb.HeadObjectRequest{Bucket: "bucket", Key: "object"}); err != nil {
+			t.Fatalf("HeadObject() error = %v", err)
+		}
+	}
+	for _, stats := range pool.stats() {
+		if stats.Requests != 2 || stats.InFlight != 0 {
+			t.Errorf("channel %d: %d requests, %d in flight; want 2 and 0", stats.Index, stats.Requests, stats.InFlight)
+		}
+	}
+}
+
+func TestChannelPoolLeastLoaded(t *testing.T) {
+	pool := newTestPool(t, newFakeStorage(), ChannelPoolConfig{Size: 3, Policy: PoolLeastLoaded})
+
+	// Every pick goes to an idle channel while one is left
+	busy := make(map[*pooledChannel]bool)
+	for i := 0; i < len(pool.channels); i++ {
+		channel := pool.pick()
+		if busy[channel] {
+			t.Fatalf("pick %d chose a busy channel while another was idle", i)
+		}
+		busy[channel] = true
+		channel.acquire()
+	}
+
+	// Once one finishes it is the least loaded
+	idle := pool.channels[1]
+	idle.release()
+	if channel := pool.pick(); channel != idle {
+		t.Error("pick() did not choose the only channel with nothing in flight")
+	}
+}
+
+func TestChannelPoolReleasesStreams(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("a", nil)
+	storage.put("b", nil)
+	pool := newTestPool(t, storage, ChannelPoolConfig{Size: 2})
+	client := pb.NewObjectStorageCacheClient(pool)
+
+	// A server stream stays in flight until it has been read to the end
+	stream, err := client.ListObjects(context.Background(), &pb.ListObjectsRequest{Bucket: "bucket"})
+	if err != nil {
+		t.Fatalf("ListObjects() error = %v", err)
+	}
+	inFlight := func() (total int64) {
+		for _, stats := range pool.stats() {
+			total += stats.InFlight
+		}
+		return total
+	}
+	if got := inFlight(); got != 1 {
+		t.Errorf("requests in flight while streaming = %d, want 1", got)
+	}
+	for {
+		if _, err := stream.Recv(); err != nil {
+			break
+		}
+	}
+	if got := inFlight(); got != 0 {
+		t.Errorf("requests in flight after the stream ended = %d, want 0", got)
+	}
+
+	// So does an abandoned stream until its context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	if _, err := client.ListObjects(ctx, &pb.ListObjectsRequest{Bucket: "bucket"}); err != nil {
+		t.Fatalf("ListObjects() error = %v", err)
+	}
+	cancel()
+	deadline := time.Now().Add(time.Second)
+	for inFlight() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("requests in flight after the stream was canceled = %d, want 0", inFlight())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// Progress describes the state of an upload or download.
+type Progress struct {
+	// Bucket and Key identify the object being transferred
+	Bucket string
+	Key    string
+	// BytesTransferred is the number of bytes sent or received by the current attempt.
+	// When Compressed is true these are compressed bytes.
+	BytesTransferred int64
+	// RawBytes is the number of uncompressed bytes the transferred bytes represent.
+	// For compressed uploads it is estimated from the compression ratio; for compressed
+	// downloads it is only known once the transfer is done.
+	RawBytes int64
+	// TotalBytes is the uncompressed size of the transfer, or -1 if it is unknown
+	TotalBytes int64
+	// Compressed reports whether the data is compressed on the wire
+	Compressed bool
+	// Attempt is the current attempt, starting at 1
+	Attempt int
+	// BytesPerSecond is the throughput of the current attempt
+	BytesPerSecond float64
+	// Done is set on the final report of a successful transfer
+	Done bool
+}
+
+// WithProgress reports the progress of PutObject and GetObject to fn after every chunk.
+// fn runs on its own goroutine so a slow callback never stalls the transfer; if it falls behind,
+// intermediate reports are dropped and only the latest is delivered. The final report is always delivered
+// before the operation returns. Reads served from the local cache are not reported.
+func WithProgress(fn func(Progress)) ObjectOption {
+	return func(opts *ObjectOptions) {
+		opts.set |= optProgress
+		opts.progress = fn
+	}
+}
+
+// progressReporter delivers progress reports to a callback without blocking the caller.
+type progressReporter struct {
+	callback func(Progress)
+	mu       sync.Mutex
+	pending  *Progress
+	wake     chan struct{}
+	done     chan struct{}
+
+	// State of the current attempt
+	attempt int
+	started time.Time
+}
+
+// newProgressReporter starts a reporter for callback, or returns nil if callback is nil.
+func newProgressReporter(callback func(Progress)) *progressReporter {
+	if callback == nil {
+		return nil
+	}
+
+	reporter := &progressReporter{
+		callback: callback,
+		wake:     make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	go func() {
+		defer close(reporter.done)
+		for range reporter.wake {
+			reporter.mu.Lock()
+			progress := reporter.pending
+			reporter.pending = nil
+			reporter.mu.Unlock()
+			if progress != nil {
+				reporter.callback(*progress)
+			}
+		}
+	}()
+	return reporter
+}
+
+// startAttempt resets the throughput measurement and returns the attempt number.
+func (reporter *progressReporter) startAttempt() int {
+	if reporter == nil {
+		return 0
+	}
+	reporter.attempt++
+	reporter.started = time.Now()
+	return reporter.attempt
+}
+
+// report queues progress for delivery, replacing any report not yet delivered.
+// Attempt and BytesPerSecond are filled in from the current attempt.
+func (reporter *progressReporter) report(progress Progress) {
+	if reporter == nil {
+		return
+	}
+	progress.Attempt = reporter.attempt
+	if elapsed := time.Since(reporter.started).Seconds(); elapsed > 0 {
+		progress.BytesPerSecond = float64(progress.BytesTransferred) / elapsed
+	}
+
+	reporter.mu.Lock()
+	reporter.pending = &progress
+	reporter.mu.Unlock()
+
+	select {
+	case reporter.wake <- struct{}{}:
+	default: // A delivery is already scheduled and will pick up this report
+	}
+}
+
+// close delivers the last report and stops the reporter.
+func (reporter *progressReporter) close() {
+	if reporter == nil {
+		return
+	}
+	close(reporter.wake)
+	<-reporter.done
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestProgressReporterCoalesces(t *testing.T) {
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var delivered []int64
+	reporter := newProgressReporter(func(progress Progress) {
+		<-release
+		mu.Lock()
+		delivered = append(delivered, progress.BytesTransferred)
+		mu.Unlock()
+	})
+	reporter.startAttempt()
+
+	// The callback is stuck on the first report, so the caller must not block and later reports replace each other
+	for i := int64(1); i <= 100; i++ {
+		reporter.report(Progress{BytesTransferred: i})
+	}
+	close(release)
+	reporter.close()
+
+	if len(delivered) == 0 || len(delivered) > 3 {
+		t.Fatalf("delivered %d reports, want at most the first, one pending and the last", len(delivered))
+	}
+	if last := delivered[len(delivered)-1]; last != 100 {
+		t.Errorf("last delivered report = %d bytes, want 100", last)
+	}
+	for i := 1; i < len(delivered); i++ {
+		if delivered[i] <= delivered[i-1] {
+			t.Errorf("reports delivered out of order: %v", delivered)
+		}
+	}
+}
+
+func TestProgressReporterAttempts(t *testing.T) {
+	var reports []Progress
+	reporter := newProgressReporter(func(progress Progress) {
+		reports = append(reports, progress)
+	})
+	reporter.startAttempt()
+	reporter.report(Progress{BytesTransferred: 10})
+	reporter.close()
+	if len(reports) != 1 || reports[0].Attempt != 1 {
+		t.Fatalf("reports = %+v, want one report of attempt 1", reports)
+	}
+
+	var nilReporter *progressReporter
+	if attempt := nilReporter.startAttempt(); attempt != 0 {
+		t.Errorf("startAttempt() without a callback = %d, want 0", attempt)
+	}
+	nilReporter.report(Progress{})
+	nilReporter.close()
+}
+
+func TestWithProgressFinalReport(t *testing.T) {
+	storage := newFakeStorage()
+	client := newTestClient(t, storage)
+	data := testData(3*1024*1024, 1)
+	ctx := context.Background()
+
+	// The final report has been delivered by the time the operation returns
+	var upload []Progress
+	if err := client.PutObject(ctx, "bucket", "object", data, WithProgress(func(p Progress) { upload = append(upload, p) })); err != nil {
+		t.Fatalf("PutObject() error = %v", err)
+	}
+	if len(upload) == 0 {
+		t.Fatal("PutObject() reported no progress")
+	}
+	final := upload[len(upload)-1]
+	if !final.Done || final.RawBytes != int64(len(data)) || final.TotalBytes != int64(len(data)) || final.Attempt != 1 {
+		t.Errorf("final upload report = %+v, want done with %d bytes", final, len(data))
+	}
+	for _, p := range upload[:len(upload)-1] {
+		if p.Done {
+			t.Errorf("intermediate upload report %+v is marked done", p)
+		}
+	}
+
+	var download []Progress
+	if _, err := client.GetObject(ctx, "bucket", "object", WithProgress(func(p Progress) { download = append(download, p) })); err != nil {
+		t.Fatalf("GetObject() error = %v", err)
+	}
+	if len(download) == 0 {
+		t.Fatal("GetObject() reported no progress")
+	}
+	if final := download[len(download)-1]; !final.Done || final.RawBytes != int64(len(data)) {
+		t.Errorf("final download report = %+v, want done with %d bytes", final, len(data))
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// RateLimits configures client-side bandwidth and request-rate limits.
+// Limits are shared by every goroutine using the client; zero means unlimited.
+type RateLimits struct {
+	// UploadBytesPerSecond limits the rate at which object data is sent
+	UploadBytesPerSecond int64
+	// DownloadBytesPerSecond limits the rate at which object data is received
+	DownloadBytesPerSecond int64
+	// RequestsPerSecond limits the request rate of each operation, keyed by RPC name such as "GetObject".
+	// Every attempt counts, including retries.
+	RequestsPerSecond map[string]float64
+}
+
+// tokenBucket is a token bucket rate limiter whose rate can be changed at any time.
+// Requests larger than the available tokens go into debt, so callers wait in arrival order.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64 // Tokens per second; zero or less is unlimited
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// setRate changes the rate; the bucket holds at most one second of tokens.
+func (bucket *tokenBucket) setRate(rate float64) {
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+
+	bucket.rate = rate
+	bucket.burst = max(rate, 1)
+	bucket.tokens = min(bucket.tokens, bucket.burst)
+	if bucket.last.IsZero() {
+		bucket.tokens = bucket.burst
+		bucket.last = time.Now()
+	}
+}
+
+// wait blocks until n tokens are available or ctx is done.
+func (bucket *tokenBucket) wait(ctx context.Context, n int) error {
+	bucket.mu.Lock()
+	if bucket.rate <= 0 {
+		bucket.mu.Unlock()
+		return nil
+	}
+
+	now := time.Now()
+	bucket.tokens = min(bucket.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*bucket.rate)
+	bucket.last = now
+	bucket.tokens -= float64(n)
+
+	var delay time.Duration
+	if bucket.tokens < 0 {
+		delay = time.Duration(-bucket.tokens / bucket.rate * float64(time.Second))
+	}
+	bucket.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		// Give back the tokens that were not used
+		bucket.mu.Lock()
+		bucket.tokens += float64(n)
+		bucket.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimiter holds the bandwidth and per-operation request limits of a client.
+type rateLimiter struct {
+	upload   tokenBucket
+	download tokenBucket
+
+	mu       sync.RWMutex
+	requests map[string]*tokenBucket // RPC name to limiter
+}
+
+// newRateLimiter creates a limiter with the given initial limits.
+func newRateLimiter(limits *RateLimits) *rateLimiter {
+	limiter := &rateLimiter{requests: make(map[string]*tokenBucket)}
+	if limits != nil {
+		limiter.upload.setRate(float64(limits.UploadBytesPerSecond))
+		limiter.download.setRate(float64(limits.DownloadBytesPerSecond))
+		for operation, rate := range limits.RequestsPerSecond {
+			limiter.setRequestRate(operation, rate)
+		}
+	}
+	return limiter
+}
+
+// setRequestRate changes the request limit of an operation.
+func (limiter *rateLimiter) setRequestRate(operation string, rate float64) {
+	limiter.mu.Lock()
+	bucket, ok := limiter.requests[operation]
+	if !ok {
+		bucket = &tokenBucket{}
+		limiter.requests[operation] = bucket
+	}
+	limiter.mu.Unlock()
+
+	bucket.setRate(rate)
+}
+
+// waitRequest blocks until a request for the RPC method may be sent.
+func (limiter *rateLimiter) waitRequest(ctx context.Context, method string) error {
+	limiter.mu.RLock()
+	bucket, ok := limiter.requests[path.Base(method)]
+	limiter.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+	return bucket.wait(ctx, 1)
+}
+
+// unaryInterceptor applies the request limits to unary RPCs.
+func (limiter *rateLimiter) unaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := limiter.waitRequest(ctx, method); err != nil {
+		return err
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// streamInterceptor applies the request limits to streaming RPCs.
+func (limiter *rateLimiter) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := limiter.waitRequest(ctx, method); err != nil {
+		return nil, err
+	}
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+// SetUploadLimit changes the upload bandwidth limit in bytes per second; zero removes the limit.
+func (client *ACSClient) SetUploadLimit(bytesPerSecond int64) {
+	client.limits.upload.setRate(float64(bytesPerSecond))
+}
+
+// SetDownloadLimit changes the download bandwidth limit in bytes per second; zero removes the limit.
+func (client *ACSClient) SetDownloadLimit(bytesPerSecond int64) {
+	client.limits.download.setRate(float64(bytesPerSecond))
+}
+
+// SetRequestLimit changes the request rate limit of an operation, named by its RPC such as "PutObject";
+// zero removes the limit.
+func (client *ACSClient) SetRequestLimit(operation string, requestsPerSecond float64) {
+	client.limits.setRequestRate(operation, requestsPerSecond)
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+func TestTokenBucketUnlimited(t *testing.T) {
+	var bucket tokenBucket
+	bucket.setRate(0)
+	start := time.Now()
+	for i := 0; i < 1000; i++ {
+		if err := bucket.wait(context.Background(), 1<<20); err != nil {
+			t.Fatalf("wait() error = %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("unlimited bucket waited %v", elapsed)
+	}
+}
+
+func TestTokenBucketThrottles(t *testing.T) {
+	var bucket tokenBucket
+	bucket.setRate(100)
+
+	// The first second's worth of tokens is available at once, the next 50 take half a second
+	start := time.Now()
+	for i := 0; i < 150; i++ {
+		if err := bucket.wait(context.Background(), 1); err != nil {
+			t.Fatalf("wait() error = %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond || elapsed > 2*time.Second {
+		t.Errorf("150 tokens at 100/s with a burst of 100 took %v, want about 500ms", elapsed)
+	}
+}
+
+func TestTokenBucketCanceledWaitRefunds(t *testing.T) {
+	var bucket tokenBucket
+	bucket.setRate(10)
+	bucket.wait(context.Background(), 10)
+
+	// A request that would wait a whole second gives its tokens back when canceled
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := bucket.wait(ctx, 10); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("wait() error = %v, want DeadlineExceeded", err)
+	}
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+	if bucket.tokens < -1 {
+		t.Errorf("tokens after a canceled wait = %v, want the debt refunded", bucket.tokens)
+	}
+}
+
+func TestTokenBucketSetRate(t *testing.T) {
+	var bucket tokenBucket
+	bucket.setRate(1)
+	bucket.wait(context.Background(), 1)
+
+	// Raising the limit applies to the next request rather than one second later
+	bucket.setRate(1000)
+	start := time.Now()
+	if err := bucket.wait(context.Background(), 10); err != nil {
+		t.Fatalf("wait() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 200*time.Millisecond {
+		t.Errorf("wait() after raising the limit took %v", elapsed)
+	}
+
+	// Removing the limit stops throttling
+	bucket.setRate(0)
+	start = time.Now()
+	if err := bucket.wait(context.Background(), 100); err != nil {
+		t.Fatalf("wait() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("wait() after removing the limit took %v", elapsed)
+	}
+}
+
+func TestSetRequestLimit(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("object", []byte("data"))
+	limits := newRateLimiter(&RateLimits{RequestsPerSecond: map[string]float64{"HeadObject": 1}})
+	client := newTestClientWith(t, storage, nil, grpc.WithChainUnaryInterceptor(limits.unaryInterceptor))
+	client.limits = limits
+
+	// headWithin makes a HeadObject request that gives up if it is throttled for longer than timeout
+	headWithin := func(timeout time.Duration) error {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		_, err := client.HeadObject(ctx, "bucket", "object")
+		return err
+	}
+
+	if err := headWithin(time.Second); err != nil {
+		t.Fatalf("HeadObject() error = %v", err)
+	}
+	if err := headWithin(20 * time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("HeadObject() over the limit error = %v, want DeadlineExceeded", err)
+	}
+	if got := storage.called("HeadObject"); got != 1 {
+		t.Errorf("HeadObject calls = %d, want 1 (the throttled request is never sent)", got)
+	}
+	if _, err := client.GetObject(context.Background(), "bucket", "object"); err != nil {
+		t.Errorf("GetObject() of an operation without a limit error = %v", err)
+	}
+
+	// Removing the limit at runtime lets requests through at once
+	client.SetRequestLimit("HeadObject", 0)
+	if err := headWithin(20 * time.Millisecond); err != nil {
+		t.Errorf("HeadObject() after removing the limit error = %v", err)
+	}
+
+	// A limit set at runtime applies from the next request
+	client.SetRequestLimit("HeadObject", 1)
+	if err := headWithin(time.Second); err != nil {
+		t.Fatalf("HeadObject() error = %v", err)
+	}
+	if err := headWithin(20 * time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("HeadObject() over a limit set at runtime error = %v, want DeadlineExceeded", err)
+	}
+}
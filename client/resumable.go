@@ -0,0 +1,387 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Resumable upload constants
+const (
+	defaultResumablePartSize    = 64 * 1024 * 1024 // 64MB parts
+	defaultResumableConcurrency = 4                // Parts uploaded in parallel
+	checkpointSuffix            = ".acs-upload"    // Default checkpoint path suffix
+)
+
+// ResumableUploadOptions holds optional parameters for ResumableUpload.
+type ResumableUploadOptions struct {
+	// CheckpointPath is where progress is recorded (default: the file path with ".acs-upload" appended)
+	CheckpointPath string
+	// PartSize is the size of each part (default 64MB). A resumed upload keeps the part size it started with.
+	PartSize int64
+	// Concurrency is the number of parts uploaded in parallel (default 4)
+	Concurrency int
+	// ContentType is the content type of the object
+	ContentType string
+	// UserMetadata is the user-defined metadata of the object
+	UserMetadata map[string]string
+	// VerifyParts re-reads the parts recorded in the checkpoint and re-uploads any whose checksum changed
+	VerifyParts bool
+}
+
+// sourceIdentity identifies the version of a local file being uploaded.
+type sourceIdentity struct {
+	Path    string    `json:"path"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+}
+
+// checkpointHeader is the first line of a checkpoint file.
+type checkpointHeader struct {
+	Bucket   string         `json:"bucket"`
+	Key      string         `json:"key"`
+	UploadID string         `json:"upload_id"`
+	PartSize int64          `json:"part_size"`
+	Source   sourceIdentity `json:"source"`
+}
+
+// checkpointPart is a line of a checkpoint file recording a completed part.
+type checkpointPart struct {
+	completedPart
+	SHA256 string `json:"sha256"`
+}
+
+// uploadCheckpoint is an append-only record of a multipart upload: a header line followed by
+// one JSON line per completed part.
+type uploadCheckpoint struct {
+	mu     sync.Mutex
+	path   string
+	file   *os.File
+	header checkpointHeader
+	parts  map[int32]checkpointPart
+}
+
+// loadUploadCheckpoint reads an existing checkpoint. It returns nil if there is none.
+func loadUploadCheckpoint(path string) (*uploadCheckpoint, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open upload checkpoint: %v", err)
+	}
+	defer f.Close()
+
+	checkpoint := &uploadCheckpoint{path: path, parts: make(map[int32]checkpointPart)}
+	scanner := bufio.NewScanner(f)
+	if !scanner.Scan() || json.Unmarshal(scanner.Bytes(), &checkpoint.header) != nil {
+		// A checkpoint without a complete header has no parts worth keeping
+		return nil, scanner.Err()
+	}
+	for scanner.Scan() {
+		var part checkpointPart
+		// A torn final line from a crash is ignored
+		if json.Unmarshal(scanner.Bytes(), &part) != nil {
+			continue
+		}
+		checkpoint.parts[part.PartNumber] = part
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read upload checkpoint: %v", err)
+	}
+	return checkpoint, nil
+}
+
+// createUploadCheckpoint writes a new checkpoint containing only the header.
+func createUploadCheckpoint(path string, header checkpointHeader) (*uploadCheckpoint, error) {
+	line, err := json.Marshal(header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal upload checkpoint: %v", err)
+	}
+	if err := writeFileSynced(path, append(line, '\n'), 0600); err != nil {
+		return nil, fmt.Errorf("failed to write upload checkpoint: %v", err)
+	}
+	return &uploadCheckpoint{path: path, header: header, parts: make(map[int32]checkpointPart)}, nil
+}
+
+// open opens the checkpoint for appending parts.
+func (checkpoint *uploadCheckpoint) open() error {
+	f, err := os.OpenFile(checkpoint.path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open upload checkpoint: %v", err)
+	}
+	checkpoint.file = f
+	return nil
+}
+
+// record appends a completed part and syncs it to disk before returning.
+func (checkpoint *uploadCheckpoint) record(part checkpointPart) error {
+	checkpoint.mu.Lock()
+	defer checkpoint.mu.Unlock()
+
+	line, err := json.Marshal(part)
+	if err != nil {
+		return fmt.Errorf("failed to marshal checkpoint part: %v", err)
+	}
+	if _, err := checkpoint.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write upload checkpoint: %v", err)
+	}
+	if err := checkpoint.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync upload checkpoint: %v", err)
+	}
+	checkpoint.parts[part.PartNumber] = part
+	return nil
+}
+
+// completed returns the recorded parts in no particular order.
+func (checkpoint *uploadCheckpoint) completed() []completedPart {
+	checkpoint.mu.Lock()
+	defer checkpoint.mu.Unlock()
+
+	parts := make([]completedPart, 0, len(checkpoint.parts))
+	for _, part := range checkpoint.parts {
+		parts = append(parts, part.completedPart)
+	}
+	return parts
+}
+
+// close closes the checkpoint file.
+func (checkpoint *uploadCheckpoint) close() {
+	checkpoint.mu.Lock()
+	defer checkpoint.mu.Unlock()
+	if checkpoint.file != nil {
+		checkpoint.file.Close()
+		checkpoint.file = nil
+	}
+}
+
+// remove deletes the checkpoint once the upload has completed or been abandoned.
+func (checkpoint *uploadCheckpoint) remove() error {
+	checkpoint.close()
+	if err := os.Remove(checkpoint.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// statSource returns the identity of the file at path.
+func statSource(path string) (sourceIdentity, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return sourceIdentity{}, err
+	}
+	return sourceIdentity{Path: path, Size: info.Size(), ModTime: info.ModTime().UTC()}, nil
+}
+
+// matches reports whether two identities describe the same version of the same file.
+func (source sourceIdentity) matches(other sourceIdentity) bool {
+	return source.Path == other.Path && source.Size == other.Size && source.ModTime.Equal(other.ModTime)
+}
+
+// ResumableUpload uploads a local file as a multipart upload that survives process restarts.
+// Every completed part is recorded in a checkpoint file. When called again for the same file, bucket and key,
+// it checks that the file's size and modification time are unchanged and only uploads the missing parts;
+// if the file changed, the old upload is aborted and a new one started. The checkpoint is removed once
+// the object has been assembled, and kept on failure so the upload can be resumed.
+func (client *ACSClient) ResumableUpload(ctx context.Context, bucket, key, filePath string, opts *ResumableUploadOptions) error {
+	options := ResumableUploadOptions{}
+	if opts != nil {
+		options = *opts
+	}
+	if options.CheckpointPath == "" {
+		options.CheckpointPath = filePath + checkpointSuffix
+	}
+	if options.PartSize <= 0 {
+		options.PartSize = defaultResumablePartSize
+	}
+	if options.Concurrency <= 0 {
+		options.Concurrency = defaultResumableConcurrency
+	}
+
+	_, statErr := os.Stat(options.CheckpointPath)
+	resuming := statErr == nil
+
+	err := client.resumeUpload(ctx, bucket, key, filePath, &options)
+	if resuming && status.Code(err) == codes.NotFound {
+		// The upload expired on the server and its parts are gone, so start over once
+		if err := os.Remove(options.CheckpointPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove expired upload checkpoint: %v", err)
+		}
+		err = client.resumeUpload(ctx, bucket, key, filePath, &options)
+	}
+	return err
+}
+
+// resumeUpload continues the upload recorded in the checkpoint, or starts a new one.
+func (client *ACSClient) resumeUpload(ctx context.Context, bucket, key, filePath string, options *ResumableUploadOptions) error {
+	source, err := statSource(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read upload source: %v", err)
+	}
+
+	checkpoint, err := loadUploadCheckpoint(options.CheckpointPath)
+	if err != nil {
+		return err
+	}
+	if checkpoint != nil {
+		header := checkpoint.header
+		if header.Bucket != bucket || header.Key != key {
+			return fmt.Errorf("checkpoint %s belongs to an upload to %s/%s", options.CheckpointPath, header.Bucket, header.Key)
+		}
+		if !header.Source.matches(source) {
+			// The file changed since the parts were uploaded, so none of them can be reused
+			if err := client.abortMultipartUpload(bucket, key, header.UploadID); err != nil {
+				fmt.Printf("Warning: Failed to abort stale multipart upload: %v\n", err)
+			}
+			checkpoint = nil
+		}
+	}
+
+	if checkpoint == nil {
+		uploadID, err := client.createMultipartUpload(ctx, bucket, key, options.ContentType, options.UserMetadata, nil)
+		if err != nil {
+			return err
+		}
+		checkpoint, err = createUploadCheckpoint(options.CheckpointPath, checkpointHeader{
+			Bucket:   bucket,
+			Key:      key,
+			UploadID: uploadID,
+			PartSize: multipartPartSize(source.Size, options.PartSize),
+			Source:   source,
+		})
+		if err != nil {
+			if abortErr := client.abortMultipartUpload(bucket, key, uploadID); abortErr != nil {
+				fmt.Printf("Warning: Failed to abort multipart upload: %v\n", abortErr)
+			}
+			return err
+		}
+	}
+	if err := checkpoint.open(); err != nil {
+		return err
+	}
+	defer checkpoint.close()
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to open upload source: %v", err)
+	}
+	defer f.Close()
+
+	if err := client.uploadMissingParts(ctx, f, checkpoint, options); err != nil {
+		return err
+	}
+
+	// Refuse to assemble parts taken from a file that changed while it was being read
+	if current, err := statSource(filePath); err != nil || !current.matches(source) {
+		return fmt.Errorf("upload source %s changed during the upload", filePath)
+	}
+
+	header := checkpoint.header
+	if _, err := client.completeMultipartUpload(ctx, bucket, key, header.UploadID, checkpoint.completed()); err != nil {
+		return err
+	}
+
+	if err := checkpoint.remove(); err != nil {
+		fmt.Printf("Warning: Failed to remove upload checkpoint: %v\n", err)
+	}
+	return nil
+}
+
+// uploadMissingParts uploads every part not yet recorded in the checkpoint with a bounded number of workers.
+// With VerifyParts, recorded parts are re-read and re-uploaded if their checksum no longer matches.
+// The first failure cancels the remaining parts.
+func (client *ACSClient) uploadMissingParts(ctx context.Context, f *os.File, checkpoint *uploadCheckpoint, options *ResumableUploadOptions) error {
+	header := checkpoint.header
+	partCount := int((header.Source.Size + header.PartSize - 1) / header.PartSize)
+	if partCount == 0 {
+		partCount = 1 // An empty file is uploaded as a single empty part
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	partNumbers := make(chan int32)
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	for i := 0; i < min(options.Concurrency, partCount); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			buf := make([]byte, header.PartSize)
+			for partNumber := range partNumbers {
+				if err := client.uploadCheckpointedPart(ctx, f, checkpoint, partNumber, buf, options.VerifyParts); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+	for partNumber := int32(1); partNumber <= int32(partCount); partNumber++ {
+		select {
+		case partNumbers <- partNumber:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(partNumbers)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// uploadCheckpointedPart reads one part of the file, uploads it unless the checkpoint already has it,
+// and records it in the checkpoint.
+func (client *ACSClient) uploadCheckpointedPart(ctx context.Context, f *os.File, checkpoint *uploadCheckpoint, partNumber int32, buf []byte, verify bool) error {
+	checkpoint.mu.Lock()
+	recorded, done := checkpoint.parts[partNumber]
+	checkpoint.mu.Unlock()
+	if done && !verify {
+		return nil
+	}
+
+	header := checkpoint.header
+	offset := int64(partNumber-1) * header.PartSize
+	length := min(header.PartSize, header.Source.Size-offset)
+	data := buf[:length]
+	if _, err := f.ReadAt(data, offset); err != nil && err != io.EOF {
+		return fmt.Errorf("failed to read part %d: %v", partNumber, err)
+	}
+
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+	if done && recorded.SHA256 == checksum {
+		return nil
+	}
+
+	etag, err := client.uploadPart(ctx, header.Bucket, header.Key, header.UploadID, partNumber, data, checksum)
+	if err != nil {
+		return err
+	}
+	return checkpoint.record(checkpointPart{
+		completedPart: completedPart{PartNumber: partNumber, ETag: etag},
+		SHA256:        checksum,
+	})
+}
@@ -0,0 +1,188 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// failUploadPart fails the nth UploadPart request that reaches the server, as if the process died mid-upload.
+func failUploadPart(n int32) grpc.ServerOption {
+	var calls atomic.Int32
+	return grpc.StreamInterceptor(func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if info.FullMethod == pb.ObjectStorageCache_UploadPart_FullMethodName && calls.Add(1) == n {
+			return status.Error(codes.Internal, "connection lost")
+		}
+		return handler(srv, stream)
+	})
+}
+
+// writeUploadSource writes a file of three 5MB parts, the last one short.
+func writeUploadSource(t *testing.T, seed byte) (string, []byte) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "source")
+	data := testData(2*minMultipartPart+1000, seed)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path, data
+}
+
+func TestLoadUploadCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+	if checkpoint, err := loadUploadCheckpoint(filepath.Join(dir, "missing")); checkpoint != nil || err != nil {
+		t.Errorf("loadUploadCheckpoint() of a missing file = %v, %v; want nil, nil", checkpoint, err)
+	}
+
+	// A crash can leave a torn final line, which is ignored
+	path := filepath.Join(dir, "checkpoint")
+	contents := `{"bucket":"bucket","key":"key","upload_id":"upload-1","part_size":5242880,"source":{"path":"source","size":10,"mod_time":"2025-01-02T03:04:05Z"}}
+{"part_number":1,"etag":"etag-1","sha256":"sum-1"}
+{"part_number":2,"etag":"etag-2","sha256":"sum-2"}
+{"part_number":3,"et`
+	if err := os.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+	checkpoint, err := loadUploadCheckpoint(path)
+	if err != nil {
+		t.Fatalf("loadUploadCheckpoint() error = %v", err)
+	}
+	if checkpoint.header.UploadID != "upload-1" || checkpoint.header.PartSize != 5242880 {
+		t.Errorf("header = %+v", checkpoint.header)
+	}
+	if len(checkpoint.parts) != 2 || checkpoint.parts[2].ETag != "etag-2" || checkpoint.parts[2].SHA256 != "sum-2" {
+		t.Errorf("parts = %+v, want parts 1 and 2", checkpoint.parts)
+	}
+
+	// Without a complete header there is nothing to resume
+	if err := os.WriteFile(path, []byte(`{"bucket":`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if checkpoint, err := loadUploadCheckpoint(path); checkpoint != nil || err != nil {
+		t.Errorf("loadUploadCheckpoint() of a torn header = %v, %v; want nil, nil", checkpoint, err)
+	}
+}
+
+func TestResumableUploadResumes(t *testing.T) {
+	path, data := writeUploadSource(t, 1)
+	storage := newFakeStorage()
+	options := &ResumableUploadOptions{PartSize: minMultipartPart, Concurrency: 1}
+
+	client := newTestClientWith(t, storage, []grpc.ServerOption{failUploadPart(3)})
+	if err := client.ResumableUpload(context.Background(), "bucket", "object", path, options); err == nil {
+		t.Fatal("ResumableUpload() succeeded, want the failed part reported")
+	}
+	if _, err := os.Stat(path + checkpointSuffix); err != nil {
+		t.Fatalf("checkpoint was not kept after the failure: %v", err)
+	}
+
+	// The restarted upload only sends the part that is missing
+	client = newTestClient(t, storage)
+	if err := client.ResumableUpload(context.Background(), "bucket", "object", path, options); err != nil {
+		t.Fatalf("resumed ResumableUpload() error = %v", err)
+	}
+	if got := storage.called("UploadPart"); got != 3 {
+		t.Errorf("UploadPart calls = %d, want 3 (two before the failure, one after)", got)
+	}
+	if got := storage.called("CreateMultipartUpload"); got != 1 {
+		t.Errorf("CreateMultipartUpload calls = %d, want 1", got)
+	}
+	object, ok := storage.object("object")
+	if !ok || !bytes.Equal(object.data, data) {
+		t.Error("object does not hold the uploaded file")
+	}
+	if _, err := os.Stat(path + checkpointSuffix); !os.IsNotExist(err) {
+		t.Errorf("checkpoint still exists after the upload completed: %v", err)
+	}
+}
+
+func TestResumableUploadRestartsChangedSource(t *testing.T) {
+	path, _ := writeUploadSource(t, 1)
+	storage := newFakeStorage()
+	options := &ResumableUploadOptions{PartSize: minMultipartPart, Concurrency: 1}
+
+	client := newTestClientWith(t, storage, []grpc.ServerOption{failUploadPart(3)})
+	if err := client.ResumableUpload(context.Background(), "bucket", "object", path, options); err == nil {
+		t.Fatal("ResumableUpload() succeeded, want the failed part reported")
+	}
+
+	// The file is rewritten with the same size, so only its modification time tells the versions apart
+	data := testData(2*minMultipartPart+1000, 2)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	client = newTestClient(t, storage)
+	if err := client.ResumableUpload(context.Background(), "bucket", "object", path, options); err != nil {
+		t.Fatalf("ResumableUpload() of the changed file error = %v", err)
+	}
+	if got := storage.called("AbortMultipartUpload"); got != 1 {
+		t.Errorf("AbortMultipartUpload calls = %d, want the stale upload aborted", got)
+	}
+	if got := storage.called("UploadPart"); got != 5 {
+		t.Errorf("UploadPart calls = %d, want 5 (two before the change, three after)", got)
+	}
+	object, ok := storage.object("object")
+	if !ok || !bytes.Equal(object.data, data) {
+		t.Error("object does not hold the changed file")
+	}
+}
+
+func TestResumableUploadRestartsExpiredUpload(t *testing.T) {
+	path, data := writeUploadSource(t, 1)
+	storage := newFakeStorage()
+	options := &ResumableUploadOptions{PartSize: minMultipartPart, Concurrency: 1}
+
+	client := newTestClientWith(t, storage, []grpc.ServerOption{failUploadPart(3)})
+	if err := client.ResumableUpload(context.Background(), "bucket", "object", path, options); err == nil {
+		t.Fatal("ResumableUpload() succeeded, want the failed part reported")
+	}
+
+	// The server dropped the upload and its parts while the client was away
+	storage.mu.Lock()
+	clear(storage.uploads)
+	storage.mu.Unlock()
+
+	client = newTestClient(t, storage)
+	if err := client.ResumableUpload(context.Background(), "bucket", "object", path, options); err != nil {
+		t.Fatalf("ResumableUpload() after the upload expired error = %v", err)
+	}
+	if got := storage.called("CreateMultipartUpload"); got != 2 {
+		t.Errorf("CreateMultipartUpload calls = %d, want a new upload started", got)
+	}
+	object, ok := storage.object("object")
+	if !ok || !bytes.Equal(object.data, data) {
+		t.Error("object does not hold the uploaded file")
+	}
+}
+
+func TestResumableUploadRejectsOtherCheckpoint(t *testing.T) {
+	path, _ := writeUploadSource(t, 1)
+	storage := newFakeStorage()
+	options := &ResumableUploadOptions{PartSize: minMultipartPart, Concurrency: 1}
+
+	client := newTestClientWith(t, storage, []grpc.ServerOption{failUploadPart(1)})
+	if err := client.ResumableUpload(context.Background(), "bucket", "object", path, options); err == nil {
+		t.Fatal("ResumableUpload() succeeded, want the failed part reported")
+	}
+
+	err := newTestClient(t, storage).ResumableUpload(context.Background(), "bucket", "other", path, options)
+	if err == nil || !strings.Contains(err.Error(), "belongs to an upload to bucket/object") {
+		t.Errorf("ResumableUpload() to another key error = %v, want the checkpoint conflict reported", err)
+	}
+}
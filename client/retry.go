@@ -21,6 +21,13 @@ type RetryConfig struct {
 	MaxBackoff time.Duration
 	// BackoffMultipler is the multiplier for exponential backoff.
 	BackoffMultipler float64
+
+	// reauthenticate re-establishes the session after an Unauthenticated error, if set
+	reauthenticate func(context.Context) error
+	// breaker fails attempts fast while the service is down, if set
+	breaker *circuitBreaker
+	// limiter bounds the attempts in flight across the client, if set
+	limiter *adaptiveLimiter
 }
 
 // DefaultRetryConfig provides reasonable default values for retry behavior.
@@ -31,6 +38,15 @@ var DefaultRetryConfig = RetryConfig{
 	BackoffMultipler: 2.0,
 }
 
+// singleAttempt returns a copy of the config that makes a single attempt, for operations the service may
+// have applied even though the attempt failed and that cannot safely be repeated: a repeated create, delete,
+// copy or multipart completion can fail spuriously or leave extra versions and uploads behind.
+// Re-authentication still replays the attempt, since an Unauthenticated error means it was rejected.
+func (config RetryConfig) singleAttempt() RetryConfig {
+	config.MaxAttempts = 1
+	return config
+}
+
 // shouldRetry determines if an error should trigger a retry
 func shouldRetry(err error) bool {
 	if err == nil {
@@ -53,11 +69,36 @@ func shouldRetry(err error) bool {
 	}
 }
 
+// isUnauthenticated reports whether err is a gRPC Unauthenticated error
+func isUnauthenticated(err error) bool {
+	return err != nil && status.Code(err) == codes.Unauthenticated
+}
+
+// attemptOnce makes a single attempt of operation, admitted by the circuit breaker and the adaptive
+// limiter and recorded by both, so attempts replayed after re-authentication are accounted like any other.
+func attemptOnce[T any](ctx context.Context, config RetryConfig, operation func(context.Context) (T, error)) (T, error) {
+	var result T
+	probe, err := config.breaker.allow()
+	if err != nil {
+		return result, err
+	}
+	started, err := config.limiter.acquire(ctx)
+	if err != nil {
+		config.breaker.record(ctx, probe, err)
+		return result, err
+	}
+	result, err = operation(ctx)
+	config.limiter.release(started, err)
+	config.breaker.record(ctx, probe, err)
+	return result, err
+}
+
 // withRetry executes the given operation with retry logic
 func withRetry[T any](ctx context.Context, config RetryConfig, operation func(context.Context) (T, error)) (T, error) {
 	var lastErr error
 	var result T
 	backoff := config.InitialBackoff
+	reauthenticated := false
 
 	for attempt := 0; attempt < config.MaxAttempts; attempt++ {
 		if attempt > 0 {
@@ -74,7 +115,14 @@ func withRetry[T any](ctx context.Context, config RetryConfig, operation func(co
 			}
 		}
 
-		result, lastErr = operation(ctx)
+		result, lastErr = attemptOnce(ctx, config, operation)
+		if isUnauthenticated(lastErr) && config.reauthenticate != nil && !reauthenticated {
+			// Re-establish the session once and replay the operation
+			reauthenticated = true
+			if err := config.reauthenticate(ctx); err == nil {
+				result, lastErr = attemptOnce(ctx, config, operation)
+			}
+		}
 		if lastErr == nil {
 			return result, nil
 		}
@@ -91,6 +139,7 @@ func withRetry[T any](ctx context.Context, config RetryConfig, operation func(co
 func withRetryNoReturn(ctx context.Context, config RetryConfig, operation func(context.Context) error) error {
 	var lastErr error
 	backoff := config.InitialBackoff
+	reauthenticated := false
 
 	for attempt := 0; attempt < config.MaxAttempts; attempt++ {
 		if attempt > 0 {
@@ -107,7 +156,18 @@ func withRetryNoReturn(ctx context.Context, config RetryConfig, operation func(c
 			}
 		}
 
-		lastErr = operation(ctx)
+		_, lastErr = attemptOnce(ctx, config, func(ctx context.Context) (struct{}, error) {
+			return struct{}{}, operation(ctx)
+		})
+		if isUnauthenticated(lastErr) && config.reauthenticate != nil && !reauthenticated {
+			// Re-establish the session once and replay the operation
+			reauthenticated = true
+			if err := config.reauthenticate(ctx); err == nil {
+				_, lastErr = attemptOnce(ctx, config, func(ctx context.Context) (struct{}, error) {
+					return struct{}{}, operation(ctx)
+				})
+			}
+		}
 		if lastErr == nil {
 			return nil
 		}
@@ -0,0 +1,73 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testRetryConfig is DefaultRetryConfig without the waits between attempts.
+func testRetryConfig() RetryConfig {
+	config := DefaultRetryConfig
+	config.InitialBackoff = time.Millisecond
+	config.MaxBackoff = time.Millisecond
+	return config
+}
+
+func TestRetryRepeatsTransientErrors(t *testing.T) {
+	config := testRetryConfig()
+	attempts := 0
+	err := withRetryNoReturn(context.Background(), config, func(ctx context.Context) error {
+		attempts++
+		return status.Error(codes.Unavailable, "unavailable")
+	})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("withRetryNoReturn() error = %v, want Unavailable", err)
+	}
+	if attempts != config.MaxAttempts {
+		t.Errorf("attempts = %d, want %d", attempts, config.MaxAttempts)
+	}
+}
+
+func TestSingleAttemptDoesNotRetry(t *testing.T) {
+	attempts := 0
+	_, err := withRetry(context.Background(), testRetryConfig().singleAttempt(), func(ctx context.Context) (string, error) {
+		attempts++
+		return "", status.Error(codes.Unavailable, "unavailable")
+	})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("withRetry() error = %v, want Unavailable", err)
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1", attempts)
+	}
+}
+
+func TestSingleAttemptReplaysAfterReauthentication(t *testing.T) {
+	config := testRetryConfig().singleAttempt()
+	reauthenticated := 0
+	config.reauthenticate = func(ctx context.Context) error {
+		reauthenticated++
+		return nil
+	}
+
+	// The service rejected the first attempt outright, so replaying it cannot apply it twice
+	attempts := 0
+	err := withRetryNoReturn(context.Background(), config, func(ctx context.Context) error {
+		attempts++
+		if attempts == 1 {
+			return status.Error(codes.Unauthenticated, "session expired")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("withRetryNoReturn() error = %v", err)
+	}
+	if attempts != 2 || reauthenticated != 1 {
+		t.Errorf("attempts = %d, re-authentications = %d; want 2 and 1", attempts, reauthenticated)
+	}
+}
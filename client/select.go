@@ -0,0 +1,268 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+)
+
+// Select serialization formats and compression types
+const (
+	// SelectCSV reads or writes comma-separated records
+	SelectCSV = "CSV"
+	// SelectJSONLines reads or writes one JSON document per line
+	SelectJSONLines = "JSON"
+
+	// SelectCompressionNone reads an uncompressed object
+	SelectCompressionNone = "NONE"
+	// SelectCompressionGzip reads a gzip-compressed object
+	SelectCompressionGzip = "GZIP"
+	// SelectCompressionLZ4 reads an LZ4-compressed object
+	SelectCompressionLZ4 = "LZ4"
+)
+
+// CSV header handling for SelectObjectContent
+const (
+	// CSVHeaderUse treats the first line as column names that the expression can refer to
+	CSVHeaderUse = "USE"
+	// CSVHeaderIgnore skips the first line
+	CSVHeaderIgnore = "IGNORE"
+	// CSVHeaderNone treats the first line as a record
+	CSVHeaderNone = "NONE"
+)
+
+// SelectObjectInput describes a query over the contents of a CSV or JSON lines object.
+type SelectObjectInput struct {
+	// Bucket is the bucket of the object to query
+	Bucket string
+	// Key is the key of the object to query
+	Key string
+	// VersionID optionally selects a version of the object
+	VersionID string
+	// Expression is the SQL expression to run, e.g. "SELECT s.name FROM S3Object s WHERE s.age > 30"
+	Expression string
+
+	// InputFormat is SelectCSV or SelectJSONLines
+	InputFormat string
+	// InputCompression is SelectCompressionNone (default), SelectCompressionGzip or SelectCompressionLZ4
+	InputCompression string
+	// CSVInput configures how CSV input is parsed
+	CSVInput CSVInputOptions
+
+	// OutputFormat is SelectCSV or SelectJSONLines (default InputFormat)
+	OutputFormat string
+	// CSVOutput configures how CSV output is written
+	CSVOutput CSVOutputOptions
+
+	// SSECustomerKey is required for objects encrypted with a customer-provided key
+	SSECustomerKey []byte
+}
+
+// CSVInputOptions configures how CSV records are parsed. Empty fields use the service defaults.
+type CSVInputOptions struct {
+	// FileHeaderInfo is CSVHeaderUse, CSVHeaderIgnore or CSVHeaderNone (default)
+	FileHeaderInfo string
+	// RecordDelimiter separates records (default "\n")
+	RecordDelimiter string
+	// FieldDelimiter separates fields (default ",")
+	FieldDelimiter string
+	// QuoteCharacter quotes fields containing delimiters (default `"`)
+	QuoteCharacter string
+	// Comments marks lines to skip when they start with it
+	Comments string
+}
+
+// CSVOutputOptions configures how CSV records are written. Empty fields use the service defaults.
+type CSVOutputOptions struct {
+	// RecordDelimiter separates records (default "\n")
+	RecordDelimiter string
+	// FieldDelimiter separates fields (default ",")
+	FieldDelimiter string
+	// QuoteCharacter quotes fields containing delimiters (default `"`)
+	QuoteCharacter string
+}
+
+// SelectStats reports how much of an object a query has read and returned.
+type SelectStats struct {
+	// BytesScanned is the number of bytes of the stored object read
+	BytesScanned int64
+	// BytesProcessed is the number of bytes processed after decompression
+	BytesProcessed int64
+	// BytesReturned is the number of bytes of records returned
+	BytesReturned int64
+}
+
+// SelectEvent is one event of a SelectObjectContent query. Exactly one field is set.
+type SelectEvent struct {
+	// Records holds one or more whole records in the output format
+	Records []byte
+	// Progress reports the progress of the query so far
+	Progress *SelectStats
+	// Stats reports the totals of the query once it has finished
+	Stats *SelectStats
+}
+
+// validate checks the input and applies defaults.
+func (input *SelectObjectInput) validate() error {
+	if input.Expression == "" {
+		return fmt.Errorf("select expression must not be empty")
+	}
+	switch input.InputFormat {
+	case SelectCSV, SelectJSONLines:
+	default:
+		return fmt.Errorf("invalid select input format %q: must be %q or %q", input.InputFormat, SelectCSV, SelectJSONLines)
+	}
+	switch input.InputCompression {
+	case "":
+		input.InputCompression = SelectCompressionNone
+	case SelectCompressionNone, SelectCompressionGzip, SelectCompressionLZ4:
+	default:
+		return fmt.Errorf("invalid select input compression %q: must be %q, %q or %q",
+			input.InputCompression, SelectCompressionNone, SelectCompressionGzip, SelectCompressionLZ4)
+	}
+	switch input.CSVInput.FileHeaderInfo {
+	case "", CSVHeaderUse, CSVHeaderIgnore, CSVHeaderNone:
+	default:
+		return fmt.Errorf("invalid CSV header info %q: must be %q, %q or %q",
+			input.CSVInput.FileHeaderInfo, CSVHeaderUse, CSVHeaderIgnore, CSVHeaderNone)
+	}
+	switch input.OutputFormat {
+	case "":
+		input.OutputFormat = input.InputFormat
+	case SelectCSV, SelectJSONLines:
+	default:
+		return fmt.Errorf("invalid select output format %q: must be %q or %q", input.OutputFormat, SelectCSV, SelectJSONLines)
+	}
+	return validateEncryption("", "", input.SSECustomerKey, nil)
+}
+
+// toProto converts the input to its wire representation.
+func (input *SelectObjectInput) toProto() *pb.SelectObjectContentRequest {
+	req := &pb.SelectObjectContentRequest{
+		Bucket:     input.Bucket,
+		Key:        input.Key,
+		Expression: input.Expression,
+		InputSerialization: &pb.InputSerialization{
+			CompressionType: input.InputCompression,
+		},
+		OutputSerialization: &pb.OutputSerialization{},
+		SseCustomerKey:      sseCustomerKey(input.SSECustomerKey),
+	}
+	if input.VersionID != "" {
+		req.VersionId = &input.VersionID
+	}
+
+	if input.InputFormat == SelectCSV {
+		req.InputSerialization.Format = &pb.InputSerialization_Csv{Csv: &pb.CSVInput{
+			FileHeaderInfo:  input.CSVInput.FileHeaderInfo,
+			RecordDelimiter: input.CSVInput.RecordDelimiter,
+			FieldDelimiter:  input.CSVInput.FieldDelimiter,
+			QuoteCharacter:  input.CSVInput.QuoteCharacter,
+			Comments:        input.CSVInput.Comments,
+		}}
+	} else {
+		req.InputSerialization.Format = &pb.InputSerialization_Json{Json: &pb.JSONInput{}}
+	}
+
+	if input.OutputFormat == SelectCSV {
+		req.OutputSerialization.Format = &pb.OutputSerialization_Csv{Csv: &pb.CSVOutput{
+			RecordDelimiter: input.CSVOutput.RecordDelimiter,
+			FieldDelimiter:  input.CSVOutput.FieldDelimiter,
+			QuoteCharacter:  input.CSVOutput.QuoteCharacter,
+		}}
+	} else {
+		req.OutputSerialization.Format = &pb.OutputSerialization_Json{Json: &pb.JSONOutput{}}
+	}
+	return req
+}
+
+// selectStatsFromProto converts query statistics from their wire representation.
+func selectStatsFromProto(stats *pb.SelectStats) *SelectStats {
+	return &SelectStats{
+		BytesScanned:   stats.GetBytesScanned(),
+		BytesProcessed: stats.GetBytesProcessed(),
+		BytesReturned:  stats.GetBytesReturned(),
+	}
+}
+
+// selectEventFromProto converts a query event from its wire representation.
+func selectEventFromProto(resp *pb.SelectObjectContentResponse) (*SelectEvent, error) {
+	switch event := resp.Event.(type) {
+	case *pb.SelectObjectContentResponse_Records:
+		return &SelectEvent{Records: event.Records}, nil
+	case *pb.SelectObjectContentResponse_Progress:
+		return &SelectEvent{Progress: selectStatsFromProto(event.Progress)}, nil
+	case *pb.SelectObjectContentResponse_Stats:
+		return &SelectEvent{Stats: selectStatsFromProto(event.Stats)}, nil
+	default:
+		return nil, fmt.Errorf("unknown select event")
+	}
+}
+
+// SelectObjectContent runs a SQL expression over a CSV or JSON lines object on the server and yields the
+// matching records, progress and final statistics as they arrive, so only the matching rows are downloaded.
+// Starting the query is retried; once the first event has been received a failure ends the sequence with
+// an error, because records already yielded cannot be taken back.
+func (client *ACSClient) SelectObjectContent(ctx context.Context, input SelectObjectInput) iter.Seq2[*SelectEvent, error] {
+	return func(yield func(*SelectEvent, error) bool) {
+		if err := input.validate(); err != nil {
+			yield(nil, err)
+			return
+		}
+
+		// Cancel the stream if the caller stops early
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		type started struct {
+			stream pb.ObjectStorageCache_SelectObjectContentClient
+			first  *pb.SelectObjectContentResponse
+		}
+		start, err := withRetry(ctx, client.retry, func(ctx context.Context) (started, error) {
+			stream, err := client.client.SelectObjectContent(withSigningResource(ctx, input.Bucket, input.Key), input.toProto())
+			if err != nil {
+				return started{}, fmt.Errorf("failed to start SelectObjectContent stream: %w", err)
+			}
+			first, err := stream.Recv()
+			if err != nil && err != io.EOF {
+				return started{}, fmt.Errorf("failed to select object content: %w", err)
+			}
+			return started{stream: stream, first: first}, nil
+		})
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+
+		for resp := start.first; resp != nil; {
+			event, err := selectEventFromProto(resp)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if event.Records != nil {
+				if err := client.limits.download.wait(ctx, len(event.Records)); err != nil {
+					yield(nil, err)
+					return
+				}
+			}
+			if !yield(event, nil) {
+				return
+			}
+
+			resp, err = start.stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				yield(nil, fmt.Errorf("error receiving select events: %w", err))
+				return
+			}
+		}
+	}
+}
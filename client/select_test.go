@@ -0,0 +1,179 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+)
+
+// selectStorage is a fakeStorage that answers every query with the same events, recording each request.
+type selectStorage struct {
+	*fakeStorage
+	events   []*pb.SelectObjectContentResponse
+	requests []*pb.SelectObjectContentRequest
+}
+
+func (s *selectStorage) SelectObjectContent(req *pb.SelectObjectContentRequest, stream pb.ObjectStorageCache_SelectObjectContentServer) error {
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	s.mu.Unlock()
+	for _, event := range s.events {
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestSelectObjectInputValidate(t *testing.T) {
+	input := SelectObjectInput{Expression: "SELECT * FROM S3Object", InputFormat: SelectCSV}
+	if err := input.validate(); err != nil {
+		t.Fatalf("validate() error = %v", err)
+	}
+	if input.InputCompression != SelectCompressionNone || input.OutputFormat != SelectCSV {
+		t.Errorf("validate() defaults = %q, %q; want uncompressed input written back as CSV", input.InputCompression, input.OutputFormat)
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(input *SelectObjectInput)
+		wantErr string
+	}{
+		{name: "expression", modify: func(input *SelectObjectInput) { input.Expression = "" }, wantErr: "expression must not be empty"},
+		{name: "input format", modify: func(input *SelectObjectInput) { input.InputFormat = "PARQUET" }, wantErr: "invalid select input format"},
+		{name: "missing input format", modify: func(input *SelectObjectInput) { input.InputFormat = "" }, wantErr: "invalid select input format"},
+		{name: "compression", modify: func(input *SelectObjectInput) { input.InputCompression = "BZIP2" }, wantErr: "invalid select input compression"},
+		{name: "header", modify: func(input *SelectObjectInput) { input.CSVInput.FileHeaderInfo = "FIRST" }, wantErr: "invalid CSV header info"},
+		{name: "output format", modify: func(input *SelectObjectInput) { input.OutputFormat = "XML" }, wantErr: "invalid select output format"},
+		{name: "customer key", modify: func(input *SelectObjectInput) { input.SSECustomerKey = []byte("short") }, wantErr: "customer-provided key must be 32 bytes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := SelectObjectInput{Expression: "SELECT * FROM S3Object", InputFormat: SelectJSONLines}
+			tt.modify(&input)
+			if err := input.validate(); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validate() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSelectObjectInputToProto(t *testing.T) {
+	input := SelectObjectInput{
+		Bucket:      "bucket",
+		Key:         "people.csv",
+		VersionID:   "v1",
+		Expression:  "SELECT s.name FROM S3Object s",
+		InputFormat: SelectCSV,
+		CSVInput:    CSVInputOptions{FileHeaderInfo: CSVHeaderUse, FieldDelimiter: ";"},
+		// Convert CSV rows to JSON lines
+		OutputFormat: SelectJSONLines,
+	}
+	if err := input.validate(); err != nil {
+		t.Fatalf("validate() error = %v", err)
+	}
+	req := input.toProto()
+	if req.Bucket != "bucket" || req.Key != "people.csv" || req.GetVersionId() != "v1" || req.Expression != input.Expression {
+		t.Errorf("request = %v", req)
+	}
+	if csv := req.InputSerialization.GetCsv(); csv == nil || csv.FileHeaderInfo != CSVHeaderUse || csv.FieldDelimiter != ";" {
+		t.Errorf("input serialization = %v, want CSV with a header and ; delimiters", req.InputSerialization)
+	}
+	if req.InputSerialization.CompressionType != SelectCompressionNone {
+		t.Errorf("compression = %q, want %q", req.InputSerialization.CompressionType, SelectCompressionNone)
+	}
+	if req.OutputSerialization.GetJson() == nil {
+		t.Errorf("output serialization = %v, want JSON", req.OutputSerialization)
+	}
+	if req.SseCustomerKey != nil {
+		t.Errorf("customer key = %v, want none", req.SseCustomerKey)
+	}
+}
+
+func TestSelectEventFromProto(t *testing.T) {
+	stats := &pb.SelectStats{BytesScanned: 100, BytesProcessed: 200, BytesReturned: 10}
+	want := SelectStats{BytesScanned: 100, BytesProcessed: 200, BytesReturned: 10}
+
+	event, err := selectEventFromProto(&pb.SelectObjectContentResponse{Event: &pb.SelectObjectContentResponse_Records{Records: []byte("a\n")}})
+	if err != nil || string(event.Records) != "a\n" || event.Progress != nil || event.Stats != nil {
+		t.Errorf("records event = %+v, %v", event, err)
+	}
+	event, err = selectEventFromProto(&pb.SelectObjectContentResponse{Event: &pb.SelectObjectContentResponse_Progress{Progress: stats}})
+	if err != nil || event.Progress == nil || *event.Progress != want || event.Stats != nil {
+		t.Errorf("progress event = %+v, %v", event, err)
+	}
+	event, err = selectEventFromProto(&pb.SelectObjectContentResponse{Event: &pb.SelectObjectContentResponse_Stats{Stats: stats}})
+	if err != nil || event.Stats == nil || *event.Stats != want || event.Progress != nil {
+		t.Errorf("stats event = %+v, %v", event, err)
+	}
+	if _, err := selectEventFromProto(&pb.SelectObjectContentResponse{}); err == nil {
+		t.Error("selectEventFromProto() of an empty event succeeded")
+	}
+}
+
+func TestSelectObjectContent(t *testing.T) {
+	storage := &selectStorage{fakeStorage: newFakeStorage(), events: []*pb.SelectObjectContentResponse{
+		{Event: &pb.SelectObjectContentResponse_Records{Records: []byte("alice\n")}},
+		{Event: &pb.SelectObjectContentResponse_Progress{Progress: &pb.SelectStats{BytesScanned: 50}}},
+		{Event: &pb.SelectObjectContentResponse_Records{Records: []byte("bob\n")}},
+		{Event: &pb.SelectObjectContentResponse_Stats{Stats: &pb.SelectStats{BytesScanned: 100, BytesReturned: 10}}},
+	}}
+	client := newTestClient(t, storage)
+	input := SelectObjectInput{Bucket: "bucket", Key: "people.csv", Expression: "SELECT s.name FROM S3Object s", InputFormat: SelectCSV}
+
+	var records bytes.Buffer
+	var progress, stats *SelectStats
+	for event, err := range client.SelectObjectContent(context.Background(), input) {
+		if err != nil {
+			t.Fatalf("SelectObjectContent() error = %v", err)
+		}
+		records.Write(event.Records)
+		if event.Progress != nil {
+			progress = event.Progress
+		}
+		if event.Stats != nil {
+			stats = event.Stats
+		}
+	}
+	if records.String() != "alice\nbob\n" {
+		t.Errorf("records = %q, want alice and bob", records.String())
+	}
+	if progress == nil || progress.BytesScanned != 50 || stats == nil || stats.BytesScanned != 100 || stats.BytesReturned != 10 {
+		t.Errorf("progress = %+v, stats = %+v", progress, stats)
+	}
+
+	// Stopping early ends the query after the events already received
+	events := 0
+	for _, err := range client.SelectObjectContent(context.Background(), input) {
+		if err != nil {
+			t.Fatalf("SelectObjectContent() error = %v", err)
+		}
+		events++
+		break
+	}
+	if events != 1 {
+		t.Errorf("events after stopping early = %d, want 1", events)
+	}
+
+	// An invalid input is reported as the only element of the sequence without a request
+	input.InputFormat = "PARQUET"
+	var errs []error
+	for event, err := range client.SelectObjectContent(context.Background(), input) {
+		if event != nil {
+			t.Errorf("SelectObjectContent() of an invalid input yielded %+v", event)
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) != 1 || errs[0] == nil || !strings.Contains(errs[0].Error(), "invalid select input format") {
+		t.Errorf("SelectObjectContent() of an invalid input errors = %v", errs)
+	}
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+	if len(storage.requests) != 2 {
+		t.Errorf("SelectObjectContent requests = %d, want 2", len(storage.requests))
+	}
+}
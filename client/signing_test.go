@@ -0,0 +1,90 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/AcceleratedCloudStorage/acs-sdk-go/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// plaintextSigner lets the test send signed requests over the in-memory connection, which has no TLS.
+type plaintextSigner struct {
+	*requestSigner
+}
+
+func (plaintextSigner) RequireTransportSecurity() bool { return false }
+
+// newSignedTestClient returns a client signing with secret against a server verifying with the real secret.
+func newSignedTestClient(t *testing.T, storage *fakeStorage, secret string) *ACSClient {
+	t.Helper()
+	verifier := &server.RequestVerifier{
+		LookupSecret: func(ctx context.Context, accessKeyID string) (string, error) {
+			if accessKeyID != "test-key" {
+				return "", fmt.Errorf("unknown access key")
+			}
+			return "test-secret", nil
+		},
+	}
+
+	signer := &requestSigner{}
+	signer.setCredentials(&credentialsContents{AccessKeyID: "test-key", SecretAccessKey: secret})
+	client := newTestClientWith(t, storage,
+		[]grpc.ServerOption{
+			grpc.UnaryInterceptor(verifier.UnaryServerInterceptor()),
+			grpc.StreamInterceptor(verifier.StreamServerInterceptor()),
+		},
+		grpc.WithPerRPCCredentials(plaintextSigner{signer}),
+		grpc.WithUnaryInterceptor(signingUnaryInterceptor),
+	)
+	client.signer = signer
+	return client
+}
+
+func TestSignedRequestsRoundTrip(t *testing.T) {
+	storage := newFakeStorage()
+	object := testData(5000, 1)
+	storage.put("object", object)
+	client := newSignedTestClient(t, storage, "test-secret")
+	ctx := context.Background()
+
+	// Unary requests are signed from the request message
+	head, err := client.HeadObject(ctx, "bucket", "object")
+	if err != nil {
+		t.Fatalf("HeadObject() error = %v", err)
+	}
+	if head.ContentLength != int64(len(object)) {
+		t.Errorf("HeadObject() ContentLength = %d, want %d", head.ContentLength, len(object))
+	}
+
+	// Streaming requests are signed from the context and verified against their first message
+	data, err := client.GetObject(ctx, "bucket", "object")
+	if err != nil {
+		t.Fatalf("GetObject() error = %v", err)
+	}
+	if !bytes.Equal(data, object) {
+		t.Errorf("GetObject() returned %d bytes that differ from the object", len(data))
+	}
+}
+
+func TestSignedRequestsWithWrongSecret(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("object", []byte("data"))
+	client := newSignedTestClient(t, storage, "wrong-secret")
+	ctx := context.Background()
+
+	if _, err := client.HeadObject(ctx, "bucket", "object"); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("HeadObject() error = %v, want Unauthenticated", err)
+	}
+	if _, err := client.GetObject(ctx, "bucket", "object"); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("GetObject() error = %v, want Unauthenticated", err)
+	}
+	if got := storage.called("HeadObject") + storage.called("GetObject"); got != 0 {
+		t.Errorf("handlers ran %d times for requests with a wrong signature", got)
+	}
+}
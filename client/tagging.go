@@ -0,0 +1,97 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+// Package client provides a Go client for interacting with the Accelerated Cloud Storage service.
+package client
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+)
+
+// PutObjectTagging replaces the tag set of an object, or of a specific version with WithVersionID.
+// The tags are validated locally before they are sent.
+// It returns an error if validation or the operation fails.
+func (client *ACSClient) PutObjectTagging(ctx context.Context, bucket, key string, tags map[string]string, options ...ObjectOption) error {
+	if err := validateObjectTags(tags); err != nil {
+		return err
+	}
+	opts, err := applyObjectOptions("PutObjectTagging", optVersionID, options)
+	if err != nil {
+		return err
+	}
+
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		req := &pb.PutObjectTaggingRequest{
+			Bucket: bucket,
+			Key:    key,
+			Tags:   tags,
+		}
+		if opts.versionID != "" {
+			req.VersionId = &opts.versionID
+		}
+
+		_, err := client.client.PutObjectTagging(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to put object tagging: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetObjectTagging retrieves the tag set of an object, or of a specific version with WithVersionID.
+// It returns the tags and an error if the operation fails.
+func (client *ACSClient) GetObjectTagging(ctx context.Context, bucket, key string, options ...ObjectOption) (map[string]string, error) {
+	opts, err := applyObjectOptions("GetObjectTagging", optVersionID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return withRetry(ctx, client.retry, func(ctx context.Context) (map[string]string, error) {
+		req := &pb.GetObjectTaggingRequest{
+			Bucket: bucket,
+			Key:    key,
+		}
+		if opts.versionID != "" {
+			req.VersionId = &opts.versionID
+		}
+
+		resp, err := client.client.GetObjectTagging(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get object tagging: %w", err)
+		}
+
+		tags := resp.Tags
+		if tags == nil {
+			tags = map[string]string{}
+		}
+		return tags, nil
+	})
+}
+
+// DeleteObjectTagging removes all tags from an object, or from a specific version with WithVersionID.
+// It returns an error if the operation fails.
+func (client *ACSClient) DeleteObjectTagging(ctx context.Context, bucket, key string, options ...ObjectOption) error {
+	opts, err := applyObjectOptions("DeleteObjectTagging", optVersionID, options)
+	if err != nil {
+		return err
+	}
+
+	return withRetryNoReturn(ctx, client.retry, func(ctx context.Context) error {
+		req := &pb.DeleteObjectTaggingRequest{
+			Bucket: bucket,
+			Key:    key,
+		}
+		if opts.versionID != "" {
+			req.VersionId = &opts.versionID
+		}
+
+		_, err := client.client.DeleteObjectTagging(ctx, req)
+		if err != nil {
+			return fmt.Errorf("failed to delete object tagging: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateObjectTags(t *testing.T) {
+	tooMany := make(map[string]string)
+	for i := 0; i <= maxObjectTags; i++ {
+		tooMany[fmt.Sprintf("key%d", i)] = "value"
+	}
+
+	tests := []struct {
+		name    string
+		tags    map[string]string
+		wantErr string
+	}{
+		{name: "valid", tags: map[string]string{"team": "data", "empty": ""}},
+		{name: "none"},
+		{name: "too many", tags: tooMany, wantErr: "maximum is 10"},
+		{name: "empty key", tags: map[string]string{"": "v"}, wantErr: "must not be empty"},
+		{name: "long key", tags: map[string]string{strings.Repeat("k", maxTagKeyLength+1): "v"}, wantErr: "exceeds 128 characters"},
+		{name: "long value", tags: map[string]string{"k": strings.Repeat("v", maxTagValueLength+1)}, wantErr: "exceeds 256 characters"},
+		{name: "reserved prefix", tags: map[string]string{"aws:owner": "v"}, wantErr: "reserved prefix"},
+		// Limits count characters, not bytes
+		{name: "multibyte value", tags: map[string]string{"k": strings.Repeat("é", maxTagValueLength)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateObjectTags(tt.tags)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validateObjectTags() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateObjectTags() error = %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestObjectTagging(t *testing.T) {
+	storage := newFakeStorage()
+	client := newTestClient(t, storage)
+	ctx := context.Background()
+
+	initial := map[string]string{"team": "data"}
+	if err := client.PutObject(ctx, "bucket", "object", []byte("data"), WithTags(initial)); err != nil {
+		t.Fatalf("PutObject() error = %v", err)
+	}
+	if tags, err := client.GetObjectTagging(ctx, "bucket", "object"); err != nil || !reflect.DeepEqual(tags, initial) {
+		t.Errorf("GetObjectTagging() after PutObject = %v, %v; want %v", tags, err, initial)
+	}
+
+	replaced := map[string]string{"tier": "cold"}
+	if err := client.PutObjectTagging(ctx, "bucket", "object", replaced); err != nil {
+		t.Fatalf("PutObjectTagging() error = %v", err)
+	}
+	if tags, err := client.GetObjectTagging(ctx, "bucket", "object"); err != nil || !reflect.DeepEqual(tags, replaced) {
+		t.Errorf("GetObjectTagging() after PutObjectTagging = %v, %v; want %v", tags, err, replaced)
+	}
+
+	// An untagged object reports an empty set rather than nil
+	if err := client.DeleteObjectTagging(ctx, "bucket", "object"); err != nil {
+		t.Fatalf("DeleteObjectTagging() error = %v", err)
+	}
+	if tags, err := client.GetObjectTagging(ctx, "bucket", "object"); err != nil || tags == nil || len(tags) != 0 {
+		t.Errorf("GetObjectTagging() after DeleteObjectTagging = %v, %v; want an empty set", tags, err)
+	}
+}
+
+func TestPutObjectTaggingValidatesLocally(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("object", []byte("data"))
+	client := newTestClient(t, storage)
+
+	err := client.PutObjectTagging(context.Background(), "bucket", "object", map[string]string{"aws:owner": "v"})
+	if err == nil || !strings.Contains(err.Error(), "reserved prefix") {
+		t.Errorf("PutObjectTagging() error = %v, want a validation error", err)
+	}
+	if got := storage.called("PutObjectTagging"); got != 0 {
+		t.Errorf("PutObjectTagging calls = %d, want 0", got)
+	}
+}
@@ -7,20 +7,49 @@ import "time"
 // serverAddress is the endpoint for the ACS service.
 const (
 	serverAddress = "acceleratedcloudstorageproduction.com:50050"
+	defaultRegion = "us-east-1" // Region used when the session does not set one
 
 	// Compression constants
-	compressionThreshold = 5 * 1024 * 1024 * 1024 // 5GB threshold
-	minSampleSize        = 1 * 1024 * 1024        // 1MB minimum sample
-	maxSampleSize        = 256 * 1024 * 1024      // 256MB maximum sample
-	sampleRatio          = 0.01                   // Sample 1% of data
-	minCompressionRatio  = 0.5                    // Only compress if we can save at least 50%
+	minSampleSize       = 1 * 1024 * 1024   // 1MB minimum sample
+	maxSampleSize       = 256 * 1024 * 1024 // 256MB maximum sample
+	sampleRatio         = 0.01              // Sample 1% of data
+	minCompressionRatio = 0.5               // Only compress if we can save at least 50%
+
+	// Tag limits
+	maxTagKeyLength   = 128 // Maximum tag key length in characters
+	maxTagValueLength = 256 // Maximum tag value length in characters
+	maxObjectTags     = 10  // Maximum number of tags on an object
+
+	// Authentication constants
+	defaultKeyRotationInterval = time.Hour        // Background key rotation check interval
+	authenticateTimeout        = 5 * time.Second  // Timeout for each Authenticate call
+	keyRotationTimeout         = 30 * time.Second // Timeout for each background rotation
 )
 
+// compressionThreshold is the object size from which PutObject considers compressing (5GB).
+// It is a variable so that tests can exercise compression without 5GB objects.
+var compressionThreshold = 5 * 1024 * 1024 * 1024
+
 // Session represents a client session configuration.
 // It contains optional parameters that can be provided when creating a new client.
 type Session struct {
 	// Region specifies the AWS region to use for this session
 	Region string
+	// Cache enables a persistent on-disk read cache for GetObject when set
+	Cache *CacheConfig
+	// KeyRotationInterval is how often the client checks in the background whether its key
+	// needs rotating. Zero uses the default of one hour; a negative value disables the check.
+	KeyRotationInterval time.Duration
+	// Pool spreads requests over several gRPC channels when set; by default a single channel is used
+	Pool *ChannelPoolConfig
+	// Limits sets initial bandwidth and request-rate limits; they can be changed later on the client
+	Limits *RateLimits
+	// Hedging enables hedged GetObject and HeadObject requests when set
+	Hedging *HedgingConfig
+	// CircuitBreaker makes requests fail fast with ErrCircuitOpen while the service is down when set
+	CircuitBreaker *CircuitBreakerConfig
+	// AdaptiveConcurrency limits requests in flight, backing off when the service throttles, when set
+	AdaptiveConcurrency *AdaptiveConcurrencyConfig
 }
 
 // HeadBucketOutput represents the metadata returned by HeadBucket operation.
@@ -49,8 +78,20 @@ type HeadObjectOutput struct {
 	UserMetadata map[string]string
 	// ServerSideEncryption specifies the type of server-side encryption used
 	ServerSideEncryption string
+	// SSEKMSKeyID is the KMS key the object is encrypted with, if any
+	SSEKMSKeyID string
+	// SSECustomerKeyMD5 is the base64 MD5 of the customer-provided key the object is encrypted with, if any
+	SSECustomerKeyMD5 string
 	// VersionId is the version identifier for the object
 	VersionId string
+	// TagCount is the number of tags on the object
+	TagCount int32
+	// ObjectLockMode is the retention mode protecting the object, if any
+	ObjectLockMode string
+	// ObjectLockRetainUntil is the time until which the object is protected by its retention
+	ObjectLockRetainUntil time.Time
+	// ObjectLockLegalHold reports whether the object is under legal hold
+	ObjectLockLegalHold bool
 }
 
 // ListObjectsOptions holds optional parameters for object listing.
@@ -77,10 +118,93 @@ type credentialsContents struct {
 // Each profile contains a set of credentials for accessing the service.
 type profileCredentials map[string]credentialsContents
 
-// GetObjectOptions holds the options for GetObject
-type GetObjectOptions struct {
+// ObjectOptions holds the optional parameters shared by object operations.
+// Each operation accepts only the options that apply to it and fails if given any other.
+type ObjectOptions struct {
+	set objectOptionSet // The options applied, checked against those the operation supports
+
 	rangeSpec string
+	versionID string
+	tags      map[string]string
+	progress  func(Progress)
+
+	retention        *ObjectRetention
+	legalHold        bool
+	bypassGovernance bool
+	encryption       objectEncryption
+
+	contentType  string
+	userMetadata map[string]string
+
+	ifMatch string // Also set by the cache to pin block fills to the ETag it validated
 }
 
+// ObjectOption is a function that configures ObjectOptions
+type ObjectOption func(*ObjectOptions)
+
+// GetObjectOptions holds the options for GetObject
+type GetObjectOptions = ObjectOptions
+
 // GetObjectOption is a function that configures GetObjectOptions
-type GetObjectOption func(*GetObjectOptions)
+type GetObjectOption = ObjectOption
+
+// objectOptionSet is a set of object options, used to reject options an operation does not support.
+type objectOptionSet uint16
+
+// Object options
+const (
+	optRange objectOptionSet = 1 << iota
+	optVersionID
+	optTags
+	optProgress
+	optRetention
+	optLegalHold
+	optBypassGovernance
+	optServerSideEncryption
+	optCustomerKey
+	optCopySourceCustomerKey
+	optIfMatch
+	optContentType
+	optUserMetadata
+
+	// copyObjectOptions are the options supported by CopyObject
+	copyObjectOptions = optVersionID | optServerSideEncryption | optCustomerKey | optCopySourceCustomerKey
+)
+
+// Bucket versioning states
+const (
+	// VersioningEnabled keeps every version of every object in the bucket
+	VersioningEnabled = "Enabled"
+	// VersioningSuspended stops creating new versions but keeps existing ones
+	VersioningSuspended = "Suspended"
+)
+
+// ObjectVersion describes one version of an object or a delete marker.
+type ObjectVersion struct {
+	// Key is the object key
+	Key string
+	// VersionId is the version identifier
+	VersionId string
+	// IsLatest reports whether this is the current version of the key
+	IsLatest bool
+	// IsDeleteMarker reports whether this version is a delete marker
+	IsDeleteMarker bool
+	// Size is the size of the version in bytes (zero for delete markers)
+	Size int64
+	// LastModified is the time the version was created
+	LastModified time.Time
+	// ETag is the entity tag of the version (empty for delete markers)
+	ETag string
+}
+
+// ListObjectVersionsOptions holds optional parameters for version listing.
+type ListObjectVersionsOptions struct {
+	// Prefix filters versions by key prefix
+	Prefix string
+	// KeyMarker returns versions of keys lexicographically after this value
+	KeyMarker string
+	// VersionIdMarker, together with KeyMarker, returns versions of KeyMarker after this version
+	VersionIdMarker string
+	// MaxKeys specifies the maximum number of versions to return
+	MaxKeys int32
+}
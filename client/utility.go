@@ -5,8 +5,11 @@ package client
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/pierrec/lz4/v4"
 	"gopkg.in/yaml.v2"
@@ -16,10 +19,124 @@ import (
 // The range should be in the format "bytes=start-end" (e.g., "bytes=0-9" for first 10 bytes)
 func WithRange(rangeSpec string) GetObjectOption {
 	return func(opts *GetObjectOptions) {
+		opts.set |= optRange
 		opts.rangeSpec = rangeSpec
 	}
 }
 
+// WithVersionID targets a specific object version in GetObject, HeadObject, DeleteObject and the object
+// tagging, retention and legal hold operations. For CopyObject it selects the version of the copy source.
+func WithVersionID(versionID string) ObjectOption {
+	return func(opts *ObjectOptions) {
+		opts.set |= optVersionID
+		opts.versionID = versionID
+	}
+}
+
+// WithTags sets the tags of an object written by PutObject.
+func WithTags(tags map[string]string) ObjectOption {
+	return func(opts *ObjectOptions) {
+		opts.set |= optTags
+		opts.tags = tags
+	}
+}
+
+// WithIfMatch makes GetObject fail with ErrPreconditionFailed unless the object's ETag equals etag,
+// so that several ranged reads are guaranteed to see the same version of the object.
+func WithIfMatch(etag string) GetObjectOption {
+	return func(opts *GetObjectOptions) {
+		opts.set |= optIfMatch
+		opts.ifMatch = etag
+	}
+}
+
+// WithContentType sets the content type of an object written by PutObject.
+func WithContentType(contentType string) ObjectOption {
+	return func(opts *ObjectOptions) {
+		opts.set |= optContentType
+		opts.contentType = contentType
+	}
+}
+
+// WithUserMetadata sets the user-defined metadata of an object written by PutObject.
+func WithUserMetadata(metadata map[string]string) ObjectOption {
+	return func(opts *ObjectOptions) {
+		opts.set |= optUserMetadata
+		opts.userMetadata = metadata
+	}
+}
+
+// objectOptionNames names each object option by the function that sets it.
+var objectOptionNames = []string{
+	"WithRange",
+	"WithVersionID",
+	"WithTags",
+	"WithProgress",
+	"WithRetention",
+	"WithLegalHold",
+	"WithBypassGovernanceRetention",
+	"WithServerSideEncryption/WithSSEKMS",
+	"WithSSECustomerKey",
+	"WithCopySourceSSECustomerKey",
+	"WithIfMatch",
+	"WithContentType",
+	"WithUserMetadata",
+}
+
+// String lists the names of the options in the set.
+func (set objectOptionSet) String() string {
+	var names []string
+	for i, name := range objectOptionNames {
+		if set&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// applyObjectOptions applies options on top of the defaults.
+// It returns an error naming the options the operation does not support, rather than silently ignoring them.
+func applyObjectOptions(operation string, supported objectOptionSet, options []ObjectOption) (*ObjectOptions, error) {
+	opts := &ObjectOptions{}
+	for _, option := range options {
+		option(opts)
+	}
+	if unsupported := opts.set &^ supported; unsupported != 0 {
+		return nil, fmt.Errorf("%s does not support %s", operation, unsupported)
+	}
+	return opts, nil
+}
+
+// validateTag checks a tag key and value against the service limits
+func validateTag(key, value string) error {
+	if key == "" {
+		return fmt.Errorf("tag key must not be empty")
+	}
+	if utf8.RuneCountInString(key) > maxTagKeyLength {
+		return fmt.Errorf("tag key %q exceeds %d characters", key, maxTagKeyLength)
+	}
+	if utf8.RuneCountInString(value) > maxTagValueLength {
+		return fmt.Errorf("tag value for key %q exceeds %d characters", key, maxTagValueLength)
+	}
+	if strings.HasPrefix(key, "aws:") {
+		return fmt.Errorf("tag key %q uses the reserved prefix \"aws:\"", key)
+	}
+	return nil
+}
+
+// validateObjectTags checks an object's tag set against the service limits
+func validateObjectTags(tags map[string]string) error {
+	if len(tags) > maxObjectTags {
+		return fmt.Errorf("object has %d tags, maximum is %d", len(tags), maxObjectTags)
+	}
+	for key, value := range tags {
+		if err := validateTag(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // estimateCompressionRatio estimates the LZ4 compression ratio by sampling the data
 func estimateCompressionRatio(data []byte) (float64, error) {
 	totalSize := len(data)
@@ -114,13 +231,7 @@ func loadACSCredentials() (*credentialsContents, error) {
 		return nil, fmt.Errorf("failed to unmarshal credentials: %v", err)
 	}
 
-	// Get profile from environment variable, default to "default" if not set
-	profile := os.Getenv("ACS_PROFILE")
-	if profile == "" {
-		fmt.Println("ACS_PROFILE environment variable not set, using 'default' profile.")
-		profile = "default"
-	}
-
+	profile := currentProfile()
 	creds, ok := profiles[profile]
 	if !ok {
 		return nil, fmt.Errorf("profile '%s' not found in credentials file", profile)
@@ -128,3 +239,27 @@ func loadACSCredentials() (*credentialsContents, error) {
 
 	return &creds, nil
 }
+
+// decompressData decompresses an lz4-compressed object.
+func decompressData(data []byte) ([]byte, error) {
+	// Create a reader for the compressed data
+	r := lz4.NewReader(bytes.NewReader(data))
+
+	// Pre-allocate decompression buffer - LZ4 typically has 2x compression ratio
+	decompressed := make([]byte, 0, len(data)*2)
+
+	// Read the decompressed data in chunks to avoid large allocations
+	chunk := make([]byte, 32*1024*1024) // 32MB chunks
+	for {
+		n, err := r.Read(chunk)
+		// The last bytes may arrive together with io.EOF
+		decompressed = append(decompressed, chunk[:n]...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress data: %v", err)
+		}
+	}
+	return decompressed, nil
+}
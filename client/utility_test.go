@@ -0,0 +1,40 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package client
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestApplyObjectOptionsRejectsUnsupported(t *testing.T) {
+	opts, err := applyObjectOptions("HeadObject", optVersionID|optCustomerKey, []ObjectOption{WithVersionID("v1")})
+	if err != nil {
+		t.Fatalf("applyObjectOptions() error = %v", err)
+	}
+	if opts.versionID != "v1" {
+		t.Errorf("versionID = %q, want %q", opts.versionID, "v1")
+	}
+
+	_, err = applyObjectOptions("HeadObject", optVersionID|optCustomerKey, []ObjectOption{WithVersionID("v1"), WithRange("bytes=0-9"), WithTags(nil)})
+	if err == nil {
+		t.Fatal("applyObjectOptions() with unsupported options succeeded")
+	}
+	if want := "HeadObject does not support WithRange, WithTags"; err.Error() != want {
+		t.Errorf("applyObjectOptions() error = %q, want %q", err, want)
+	}
+}
+
+func TestGetObjectRejectsPutOptions(t *testing.T) {
+	storage := newFakeStorage()
+	storage.put("object", []byte("data"))
+	client := newTestClient(t, storage)
+
+	_, err := client.GetObject(context.Background(), "bucket", "object", WithTags(map[string]string{"k": "v"}))
+	if err == nil || !strings.Contains(err.Error(), "GetObject does not support WithTags") {
+		t.Errorf("GetObject() with WithTags error = %v, want an unsupported option error", err)
+	}
+	if got := storage.called("GetObject"); got != 0 {
+		t.Errorf("GetObject calls = %d, want 0", got)
+	}
+}
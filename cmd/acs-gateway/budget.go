@@ -0,0 +1,34 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package main
+
+import "sync"
+
+// byteBudget bounds the number of bytes held in memory by requests in flight.
+// Requests that do not fit are turned away rather than queued, so S3 clients back off and retry.
+type byteBudget struct {
+	mu        sync.Mutex
+	available int64
+}
+
+// newByteBudget returns a budget of size bytes.
+func newByteBudget(size int64) *byteBudget {
+	return &byteBudget{available: size}
+}
+
+// reserve takes n bytes from the budget and reports whether they were available.
+func (b *byteBudget) reserve(n int64) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if n > b.available {
+		return false
+	}
+	b.available -= n
+	return true
+}
+
+// release returns n reserved bytes to the budget.
+func (b *byteBudget) release(n int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.available += n
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v2"
+)
+
+// gatewayKey is a single access key accepted by the gateway.
+// It uses the same layout as a profile in the ACS credentials file.
+type gatewayKey struct {
+	// AccessKeyID is the key ID S3 clients sign requests with
+	AccessKeyID string `yaml:"access_key_id"`
+	// SecretAccessKey is the secret used to verify signatures
+	SecretAccessKey string `yaml:"secret_access_key"`
+}
+
+// gatewayKeys maps a profile name to the key accepted for it.
+type gatewayKeys map[string]gatewayKey
+
+// loadGatewayKeys reads the keys file and indexes the secrets by access key ID.
+func loadGatewayKeys(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keys file: %v", err)
+	}
+
+	var profiles gatewayKeys
+	if err := yaml.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal keys file: %v", err)
+	}
+
+	secrets := make(map[string]string, len(profiles))
+	for name, key := range profiles {
+		if key.AccessKeyID == "" || key.SecretAccessKey == "" {
+			return nil, fmt.Errorf("profile '%s' is missing access_key_id or secret_access_key", name)
+		}
+		if _, ok := secrets[key.AccessKeyID]; ok {
+			return nil, fmt.Errorf("access key '%s' is configured more than once", key.AccessKeyID)
+		}
+		secrets[key.AccessKeyID] = key.SecretAccessKey
+	}
+	if len(secrets) == 0 {
+		return nil, fmt.Errorf("no keys configured in %s", path)
+	}
+
+	return secrets, nil
+}
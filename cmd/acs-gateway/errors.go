@@ -0,0 +1,114 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package main
+
+import (
+	"encoding/xml"
+	"errors"
+	"log"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// s3Error is an error reported to S3 clients as an XML error document.
+type s3Error struct {
+	// Code is the S3 error code, e.g. "NoSuchKey"
+	Code string
+	// Message is a human readable description
+	Message string
+	// StatusCode is the HTTP status returned with the error
+	StatusCode int
+}
+
+func (e *s3Error) Error() string {
+	return e.Code + ": " + e.Message
+}
+
+// Errors returned by the gateway itself
+var (
+	errAccessDenied         = &s3Error{"AccessDenied", "Access Denied.", http.StatusForbidden}
+	errSignatureMismatch    = &s3Error{"SignatureDoesNotMatch", "The request signature we calculated does not match the signature you provided.", http.StatusForbidden}
+	errInvalidAccessKeyID   = &s3Error{"InvalidAccessKeyId", "The access key ID you provided does not exist in our records.", http.StatusForbidden}
+	errRequestTimeTooSkewed = &s3Error{"RequestTimeTooSkewed", "The difference between the request time and the server's time is too large.", http.StatusForbidden}
+	errRequestExpired       = &s3Error{"AccessDenied", "Request has expired.", http.StatusForbidden}
+	errMissingAuth          = &s3Error{"AccessDenied", "Anonymous requests are not allowed.", http.StatusForbidden}
+	errMalformedAuth        = &s3Error{"AuthorizationHeaderMalformed", "The authorization header is malformed.", http.StatusBadRequest}
+	errMissingDate          = &s3Error{"AccessDenied", "Request is missing a valid X-Amz-Date or Date header.", http.StatusForbidden}
+	errContentSHA256        = &s3Error{"XAmzContentSHA256Mismatch", "The provided 'x-amz-content-sha256' header does not match what was computed.", http.StatusBadRequest}
+	errIncompleteBody       = &s3Error{"IncompleteBody", "The request body is malformed or truncated.", http.StatusBadRequest}
+	errEntityTooLarge       = &s3Error{"EntityTooLarge", "Your proposed upload exceeds the maximum allowed object size.", http.StatusBadRequest}
+	errNotImplemented       = &s3Error{"NotImplemented", "A header or query you provided implies functionality that is not implemented.", http.StatusNotImplemented}
+	errMethodNotAllowed     = &s3Error{"MethodNotAllowed", "The specified method is not allowed against this resource.", http.StatusMethodNotAllowed}
+	errMalformedXML         = &s3Error{"MalformedXML", "The XML you provided was not well-formed or did not validate.", http.StatusBadRequest}
+	errInvalidRange         = &s3Error{"InvalidRange", "The requested range is not satisfiable.", http.StatusRequestedRangeNotSatisfiable}
+	errInvalidArgument      = &s3Error{"InvalidArgument", "Invalid argument.", http.StatusBadRequest}
+	errInternal             = &s3Error{"InternalError", "We encountered an internal error. Please try again.", http.StatusInternalServerError}
+	errMissingContentLength = &s3Error{"MissingContentLength", "You must provide the Content-Length HTTP header.", http.StatusLengthRequired}
+	errSlowDown             = &s3Error{"SlowDown", "Please reduce your request rate.", http.StatusServiceUnavailable}
+)
+
+// errorResponse is the XML body of an S3 error.
+type errorResponse struct {
+	XMLName   xml.Name `xml:"Error"`
+	Code      string   `xml:"Code"`
+	Message   string   `xml:"Message"`
+	Resource  string   `xml:"Resource,omitempty"`
+	RequestID string   `xml:"RequestId"`
+}
+
+// translateError maps an error returned by ACSClient to an S3 error.
+// missingCode is used for NotFound since the S3 code depends on the operation.
+func translateError(err error, missingCode string) *s3Error {
+	var s3Err *s3Error
+	if errors.As(err, &s3Err) {
+		return s3Err
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return errInternal
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return &s3Error{missingCode, st.Message(), http.StatusNotFound}
+	case codes.AlreadyExists:
+		return &s3Error{"BucketAlreadyOwnedByYou", st.Message(), http.StatusConflict}
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return &s3Error{"AccessDenied", st.Message(), http.StatusForbidden}
+	case codes.InvalidArgument:
+		return &s3Error{"InvalidArgument", st.Message(), http.StatusBadRequest}
+	case codes.FailedPrecondition:
+		return &s3Error{"PreconditionFailed", st.Message(), http.StatusPreconditionFailed}
+	case codes.OutOfRange:
+		return errInvalidRange
+	case codes.Unimplemented:
+		return errNotImplemented
+	case codes.ResourceExhausted:
+		return &s3Error{"SlowDown", st.Message(), http.StatusServiceUnavailable}
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return &s3Error{"ServiceUnavailable", st.Message(), http.StatusServiceUnavailable}
+	default:
+		return &s3Error{"InternalError", st.Message(), http.StatusInternalServerError}
+	}
+}
+
+// writeError writes an S3 error document for the request.
+func writeError(w http.ResponseWriter, r *http.Request, s3Err *s3Error) {
+	if s3Err.StatusCode >= http.StatusInternalServerError {
+		log.Printf("%s %s: %v", r.Method, r.URL.Path, s3Err)
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(s3Err.StatusCode)
+	if r.Method == http.MethodHead {
+		return
+	}
+	writeXMLBody(w, errorResponse{
+		Code:      s3Err.Code,
+		Message:   s3Err.Message,
+		Resource:  r.URL.Path,
+		RequestID: w.Header().Get("x-amz-request-id"),
+	})
+}
@@ -0,0 +1,691 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AcceleratedCloudStorage/acs-sdk-go/client"
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"github.com/AcceleratedCloudStorage/acs-sdk-go/internal/byterange"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// afterPrefix sorts after any key continuing a prefix in practice; it is the largest code point,
+// since the service only accepts valid UTF-8 keys.
+const afterPrefix = "\U0010FFFF"
+
+// getObjectWindow is the size of the ranged reads a GetObject response is streamed in.
+const getObjectWindow = 8 * 1024 * 1024
+
+// unsupportedSubresources are query parameters selecting S3 features the gateway does not serve.
+var unsupportedSubresources = []string{
+	"acl", "cors", "encryption", "lifecycle", "logging", "notification", "object-lock",
+	"partNumber", "policy", "replication", "retention", "legal-hold", "tagging",
+	"uploadId", "uploads", "versioning", "versionId", "versions", "website",
+}
+
+// objectStore is the subset of ACSClient operations the gateway translates requests into.
+type objectStore interface {
+	ListBuckets(ctx context.Context) ([]*pb.Bucket, error)
+	HeadBucket(ctx context.Context, bucket string) (*client.HeadBucketOutput, error)
+	CreateBucket(ctx context.Context, bucket string) error
+	DeleteBucket(ctx context.Context, bucket string) error
+	ListObjectSummaries(ctx context.Context, bucket string, opts *client.ListObjectsOptions) ([]*pb.ObjectSummary, error)
+	HeadObject(ctx context.Context, bucket, key string, options ...client.ObjectOption) (*client.HeadObjectOutput, error)
+	GetObject(ctx context.Context, bucket, key string, options ...client.GetObjectOption) ([]byte, error)
+	PutObject(ctx context.Context, bucket, key string, data []byte, options ...client.ObjectOption) error
+	CopyObject(ctx context.Context, bucket, copySource, key string, options ...client.ObjectOption) error
+	DeleteObject(ctx context.Context, bucket, key string, options ...client.ObjectOption) error
+	DeleteObjects(ctx context.Context, bucket string, keys []string) error
+}
+
+// gateway translates S3 REST requests into ACSClient operations.
+type gateway struct {
+	client   objectStore
+	verifier *verifier
+	// maxObjectSize is the largest PutObject body accepted
+	maxObjectSize int64
+	// buffers bounds the object data held in memory across all requests in flight
+	buffers *byteBudget
+}
+
+// ServeHTTP authenticates the request and dispatches it by path and method.
+// Only path-style addressing (/bucket/key) is supported.
+func (g *gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("x-amz-request-id", newRequestID())
+	w.Header().Set("Server", "acs-gateway")
+
+	if s3Err := g.verifier.verify(r); s3Err != nil {
+		writeError(w, r, s3Err)
+		return
+	}
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+	for _, name := range unsupportedSubresources {
+		if query.Has(name) {
+			writeError(w, r, errNotImplemented)
+			return
+		}
+	}
+
+	switch {
+	case bucket == "":
+		g.serveService(w, r)
+	case key == "":
+		g.serveBucket(w, r, bucket)
+	default:
+		g.serveObject(w, r, bucket, key)
+	}
+}
+
+// serveService handles requests addressed to the service root.
+func (g *gateway) serveService(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, r, errMethodNotAllowed)
+		return
+	}
+	g.listBuckets(w, r)
+}
+
+// serveBucket handles requests addressed to a bucket.
+func (g *gateway) serveBucket(w http.ResponseWriter, r *http.Request, bucket string) {
+	query := r.URL.Query()
+	switch r.Method {
+	case http.MethodGet:
+		switch {
+		case query.Has("location"):
+			g.getBucketLocation(w, r, bucket)
+		case query.Get("list-type") == "2":
+			g.listObjects(w, r, bucket, true)
+		default:
+			g.listObjects(w, r, bucket, false)
+		}
+	case http.MethodHead:
+		g.headBucket(w, r, bucket)
+	case http.MethodPut:
+		g.createBucket(w, r, bucket)
+	case http.MethodDelete:
+		g.deleteBucket(w, r, bucket)
+	case http.MethodPost:
+		if !query.Has("delete") {
+			writeError(w, r, errNotImplemented)
+			return
+		}
+		g.deleteObjects(w, r, bucket)
+	default:
+		writeError(w, r, errMethodNotAllowed)
+	}
+}
+
+// serveObject handles requests addressed to an object.
+func (g *gateway) serveObject(w http.ResponseWriter, r *http.Request, bucket, key string) {
+	switch r.Method {
+	case http.MethodGet:
+		g.getObject(w, r, bucket, key)
+	case http.MethodHead:
+		g.headObject(w, r, bucket, key)
+	case http.MethodPut:
+		if r.Header.Get("X-Amz-Copy-Source") != "" {
+			g.copyObject(w, r, bucket, key)
+			return
+		}
+		g.putObject(w, r, bucket, key)
+	case http.MethodDelete:
+		g.deleteObject(w, r, bucket, key)
+	case http.MethodPost:
+		writeError(w, r, errNotImplemented)
+	default:
+		writeError(w, r, errMethodNotAllowed)
+	}
+}
+
+// listBuckets serves ListBuckets.
+func (g *gateway) listBuckets(w http.ResponseWriter, r *http.Request) {
+	buckets, err := g.client.ListBuckets(r.Context())
+	if err != nil {
+		writeError(w, r, translateError(err, "NoSuchBucket"))
+		return
+	}
+
+	result := listAllMyBucketsResult{
+		Xmlns: s3Namespace,
+		Owner: owner{ID: "acs", DisplayName: "acs"},
+	}
+	for _, bucket := range buckets {
+		created := bucket.CreationDate
+		if t, err := time.Parse(time.RFC3339, created); err == nil {
+			created = t.UTC().Format(s3TimeFormat)
+		}
+		result.Buckets = append(result.Buckets, bucketInfo{Name: bucket.Name, CreationDate: created})
+	}
+
+	writeXML(w, result)
+}
+
+// getBucketLocation serves GetBucketLocation from the region reported by HeadBucket.
+func (g *gateway) getBucketLocation(w http.ResponseWriter, r *http.Request, bucket string) {
+	head, err := g.client.HeadBucket(r.Context(), bucket)
+	if err != nil {
+		writeError(w, r, translateError(err, "NoSuchBucket"))
+		return
+	}
+
+	// S3 reports the default region as an empty constraint
+	location := head.Region
+	if location == "us-east-1" {
+		location = ""
+	}
+	writeXML(w, locationConstraint{Xmlns: s3Namespace, Location: location})
+}
+
+// headBucket serves HeadBucket.
+func (g *gateway) headBucket(w http.ResponseWriter, r *http.Request, bucket string) {
+	head, err := g.client.HeadBucket(r.Context(), bucket)
+	if err != nil {
+		writeError(w, r, translateError(err, "NoSuchBucket"))
+		return
+	}
+
+	w.Header().Set("x-amz-bucket-region", head.Region)
+	w.WriteHeader(http.StatusOK)
+}
+
+// createBucket serves CreateBucket. Any location constraint in the body is ignored;
+// buckets are created in the region of the gateway's session.
+func (g *gateway) createBucket(w http.ResponseWriter, r *http.Request, bucket string) {
+	if _, err := io.Copy(io.Discard, r.Body); err != nil {
+		writeError(w, r, bodyError(err))
+		return
+	}
+
+	if err := g.client.CreateBucket(r.Context(), bucket); err != nil {
+		writeError(w, r, translateError(err, "NoSuchBucket"))
+		return
+	}
+
+	w.Header().Set("Location", "/"+bucket)
+	w.WriteHeader(http.StatusOK)
+}
+
+// deleteBucket serves DeleteBucket.
+func (g *gateway) deleteBucket(w http.ResponseWriter, r *http.Request, bucket string) {
+	if err := g.client.DeleteBucket(r.Context(), bucket); err != nil {
+		writeError(w, r, translateError(err, "NoSuchBucket"))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listObjects serves ListObjects (v1) and ListObjectsV2.
+// Delimiter roll-up and pagination are applied on top of the service's prefix, start-after and max-keys filters.
+func (g *gateway) listObjects(w http.ResponseWriter, r *http.Request, bucket string, v2 bool) {
+	query := r.URL.Query()
+	prefix := query.Get("prefix")
+	delimiter := query.Get("delimiter")
+	encodingType := query.Get("encoding-type")
+	if encodingType != "" && encodingType != "url" {
+		writeError(w, r, errInvalidArgument)
+		return
+	}
+
+	maxKeys := 1000
+	if value := query.Get("max-keys"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			writeError(w, r, errInvalidArgument)
+			return
+		}
+		if n < maxKeys {
+			maxKeys = n
+		}
+	}
+
+	result := listBucketResult{
+		Xmlns:        s3Namespace,
+		Name:         bucket,
+		Prefix:       encodeKey(prefix, encodingType),
+		Delimiter:    encodeKey(delimiter, encodingType),
+		EncodingType: encodingType,
+		MaxKeys:      maxKeys,
+	}
+
+	// Determine where the listing resumes
+	var startAfter string
+	if v2 {
+		result.StartAfter = encodeKey(query.Get("start-after"), encodingType)
+		startAfter = query.Get("start-after")
+		if token := query.Get("continuation-token"); token != "" {
+			decoded, err := base64.StdEncoding.DecodeString(token)
+			if err != nil {
+				writeError(w, r, &s3Error{"InvalidArgument", "The continuation token provided is incorrect.", http.StatusBadRequest})
+				return
+			}
+			result.ContinuationToken = token
+			startAfter = string(decoded)
+		}
+	} else {
+		startAfter = query.Get("marker")
+		marker := encodeKey(startAfter, encodingType)
+		result.Marker = &marker
+	}
+
+	// Page through the listing from the marker until one entry past maxKeys shows it is truncated.
+	// Once a common prefix has been rolled up, the next page starts after every key under it.
+	var last string
+	count := 0
+	cursor := startAfter
+	for listing := maxKeys > 0; listing; {
+		objects, err := g.client.ListObjectSummaries(r.Context(), bucket, &client.ListObjectsOptions{
+			Prefix:     prefix,
+			StartAfter: cursor,
+			MaxKeys:    int32(maxKeys + 1),
+		})
+		if err != nil {
+			writeError(w, r, translateError(err, "NoSuchBucket"))
+			return
+		}
+		listing = len(objects) > maxKeys
+
+		for _, obj := range objects {
+			if obj.Key <= startAfter || !strings.HasPrefix(obj.Key, prefix) {
+				cursor = max(cursor, obj.Key)
+				continue
+			}
+
+			// Roll keys containing the delimiter after the prefix up into a common prefix
+			name, isPrefix := obj.Key, false
+			if delimiter != "" {
+				if i := strings.Index(obj.Key[len(prefix):], delimiter); i >= 0 {
+					name = obj.Key[:len(prefix)+i+len(delimiter)]
+					isPrefix = true
+				}
+			}
+			if isPrefix {
+				cursor = max(cursor, name+afterPrefix)
+				if name == last || name <= startAfter {
+					continue
+				}
+			} else {
+				cursor = max(cursor, obj.Key)
+			}
+
+			if count == maxKeys {
+				result.IsTruncated = true
+				listing = false
+				break
+			}
+			count++
+			last = name
+
+			if isPrefix {
+				result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: encodeKey(name, encodingType)})
+				continue
+			}
+			lastModified := ""
+			if obj.LastModified != nil {
+				lastModified = obj.LastModified.AsTime().UTC().Format(s3TimeFormat)
+			}
+			result.Contents = append(result.Contents, objectInfo{
+				Key:          encodeKey(obj.Key, encodingType),
+				LastModified: lastModified,
+				ETag:         quoteETag(obj.Etag),
+				Size:         obj.Size,
+				StorageClass: "STANDARD",
+			})
+		}
+	}
+
+	if result.IsTruncated {
+		if v2 {
+			result.NextContinuationToken = base64.StdEncoding.EncodeToString([]byte(last))
+		} else {
+			result.NextMarker = encodeKey(last, encodingType)
+		}
+	}
+	if v2 {
+		result.KeyCount = &count
+	}
+
+	writeXML(w, result)
+}
+
+// getObject serves GetObject, including single byte ranges.
+// The body is streamed in windows fetched with ranged reads pinned to the object's ETag,
+// so a request holds at most one window in memory and never mixes two versions of the object.
+func (g *gateway) getObject(w http.ResponseWriter, r *http.Request, bucket, key string) {
+	head, err := g.client.HeadObject(r.Context(), bucket, key)
+	if err != nil {
+		writeError(w, r, translateError(err, "NoSuchKey"))
+		return
+	}
+
+	start, end, partial := int64(0), head.ContentLength-1, false
+	if spec := r.Header.Get("Range"); spec != "" && head.ContentLength > 0 {
+		start, end, err = byterange.Parse(spec, head.ContentLength)
+		if err != nil {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", head.ContentLength))
+			writeError(w, r, errInvalidRange)
+			return
+		}
+		partial = true
+	}
+
+	window := min(end-start+1, getObjectWindow)
+	if !g.buffers.reserve(window) {
+		writeError(w, r, errSlowDown)
+		return
+	}
+	defer g.buffers.release(window)
+
+	writeHeaders := func() {
+		writeObjectHeaders(w, head)
+		w.Header().Set("Content-Length", strconv.FormatInt(end-start+1, 10))
+		if partial {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, head.ContentLength))
+			w.WriteHeader(http.StatusPartialContent)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+	}
+	if head.ContentLength == 0 {
+		writeHeaders()
+		return
+	}
+
+	for offset := start; offset <= end; offset += window {
+		last := min(offset+window, end+1) - 1
+		data, err := g.client.GetObject(r.Context(), bucket, key,
+			client.WithRange(fmt.Sprintf("bytes=%d-%d", offset, last)), client.WithIfMatch(head.ETag))
+		if err == nil && int64(len(data)) != last-offset+1 {
+			err = fmt.Errorf("read %d bytes at offset %d, expected %d", len(data), offset, last-offset+1)
+		}
+		if err != nil {
+			if offset == start {
+				writeError(w, r, translateError(err, "NoSuchKey"))
+				return
+			}
+			// The status line has been sent, so the client can only learn of the failure from a cut-off body
+			log.Printf("%s %s: %v", r.Method, r.URL.Path, err)
+			panic(http.ErrAbortHandler)
+		}
+
+		if offset == start {
+			writeHeaders()
+		}
+		if _, err := w.Write(data); err != nil {
+			return
+		}
+	}
+}
+
+// headObject serves HeadObject.
+func (g *gateway) headObject(w http.ResponseWriter, r *http.Request, bucket, key string) {
+	head, err := g.client.HeadObject(r.Context(), bucket, key)
+	if err != nil {
+		writeError(w, r, translateError(err, "NoSuchKey"))
+		return
+	}
+
+	writeObjectHeaders(w, head)
+	w.Header().Set("Content-Length", strconv.FormatInt(head.ContentLength, 10))
+	w.WriteHeader(http.StatusOK)
+}
+
+// putObject serves PutObject. The body is buffered in memory since PutObject takes a byte slice,
+// so bodies larger than the configured maximum object size are rejected and every body is
+// reserved against the gateway's buffer budget before it is read.
+func (g *gateway) putObject(w http.ResponseWriter, r *http.Request, bucket, key string) {
+	// aws-chunked bodies declare the size of the decoded object separately
+	size := r.ContentLength
+	if decoded := r.Header.Get("X-Amz-Decoded-Content-Length"); decoded != "" {
+		n, err := strconv.ParseInt(decoded, 10, 64)
+		if err != nil || n < 0 {
+			writeError(w, r, errInvalidArgument)
+			return
+		}
+		size = n
+	}
+	if size < 0 {
+		writeError(w, r, errMissingContentLength)
+		return
+	}
+	if size > g.maxObjectSize {
+		writeError(w, r, errEntityTooLarge)
+		return
+	}
+	if !g.buffers.reserve(size) {
+		writeError(w, r, errSlowDown)
+		return
+	}
+	defer g.buffers.release(size)
+
+	// Leave room for the read that detects the end of the body so the buffer never grows
+	body := bytes.NewBuffer(make([]byte, 0, size+bytes.MinRead))
+	if _, err := body.ReadFrom(http.MaxBytesReader(w, r.Body, size)); err != nil {
+		writeError(w, r, bodyError(err))
+		return
+	}
+	data := body.Bytes()
+	if int64(len(data)) != size {
+		writeError(w, r, errIncompleteBody)
+		return
+	}
+
+	sum := md5.Sum(data)
+	if contentMD5 := r.Header.Get("Content-MD5"); contentMD5 != "" {
+		if contentMD5 != base64.StdEncoding.EncodeToString(sum[:]) {
+			writeError(w, r, &s3Error{"BadDigest", "The Content-MD5 you specified did not match what we received.", http.StatusBadRequest})
+			return
+		}
+	}
+
+	var options []client.ObjectOption
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		options = append(options, client.WithContentType(contentType))
+	}
+	if metadata := userMetadata(r.Header); len(metadata) > 0 {
+		options = append(options, client.WithUserMetadata(metadata))
+	}
+	if err := g.client.PutObject(r.Context(), bucket, key, data, options...); err != nil {
+		writeError(w, r, translateError(err, "NoSuchBucket"))
+		return
+	}
+
+	// Report the service's ETag, falling back to the MD5 of the body
+	etag := hex.EncodeToString(sum[:])
+	if head, err := g.client.HeadObject(r.Context(), bucket, key); err == nil && head.ETag != "" {
+		etag = head.ETag
+	}
+	w.Header().Set("ETag", quoteETag(etag))
+	w.WriteHeader(http.StatusOK)
+}
+
+// copyObject serves CopyObject from the x-amz-copy-source header ("/bucket/key" or "bucket/key").
+func (g *gateway) copyObject(w http.ResponseWriter, r *http.Request, bucket, key string) {
+	source, err := url.PathUnescape(r.Header.Get("X-Amz-Copy-Source"))
+	if err != nil {
+		writeError(w, r, errInvalidArgument)
+		return
+	}
+	source = strings.TrimPrefix(source, "/")
+	if strings.Contains(source, "?versionId=") {
+		writeError(w, r, errNotImplemented)
+		return
+	}
+	if srcBucket, srcKey, ok := strings.Cut(source, "/"); !ok || srcBucket == "" || srcKey == "" {
+		writeError(w, r, &s3Error{"InvalidArgument", "Copy Source must mention the source bucket and key: sourcebucket/sourcekey.", http.StatusBadRequest})
+		return
+	}
+
+	if err := g.client.CopyObject(r.Context(), bucket, source, key); err != nil {
+		writeError(w, r, translateError(err, "NoSuchKey"))
+		return
+	}
+
+	head, err := g.client.HeadObject(r.Context(), bucket, key)
+	if err != nil {
+		writeError(w, r, translateError(err, "NoSuchKey"))
+		return
+	}
+
+	writeXML(w, copyObjectResult{
+		Xmlns:        s3Namespace,
+		LastModified: head.LastModified.UTC().Format(s3TimeFormat),
+		ETag:         quoteETag(head.ETag),
+	})
+}
+
+// deleteObject serves DeleteObject. Deleting a missing key succeeds, as in S3.
+func (g *gateway) deleteObject(w http.ResponseWriter, r *http.Request, bucket, key string) {
+	if err := g.client.DeleteObject(r.Context(), bucket, key); err != nil && status.Code(err) != codes.NotFound {
+		writeError(w, r, translateError(err, "NoSuchKey"))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteObjects serves DeleteObjects. If the bulk request fails, keys are deleted
+// one at a time so that each failure can be reported against its key.
+func (g *gateway) deleteObjects(w http.ResponseWriter, r *http.Request, bucket string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, r, bodyError(err))
+		return
+	}
+
+	var req deleteRequest
+	if err := xml.Unmarshal(body, &req); err != nil || len(req.Objects) == 0 || len(req.Objects) > maxDeleteObjects {
+		writeError(w, r, errMalformedXML)
+		return
+	}
+
+	keys := make([]string, len(req.Objects))
+	for i, obj := range req.Objects {
+		keys[i] = obj.Key
+	}
+
+	result := deleteResult{Xmlns: s3Namespace}
+	if err := g.client.DeleteObjects(r.Context(), bucket, keys); err == nil {
+		if !req.Quiet {
+			for _, key := range keys {
+				result.Deleted = append(result.Deleted, deletedObject{Key: key})
+			}
+		}
+		writeXML(w, result)
+		return
+	}
+
+	for _, key := range keys {
+		err := g.client.DeleteObject(r.Context(), bucket, key)
+		switch {
+		case err == nil || status.Code(err) == codes.NotFound:
+			if !req.Quiet {
+				result.Deleted = append(result.Deleted, deletedObject{Key: key})
+			}
+		default:
+			s3Err := translateError(err, "NoSuchKey")
+			result.Errors = append(result.Errors, deleteError{Key: key, Code: s3Err.Code, Message: s3Err.Message})
+		}
+	}
+	writeXML(w, result)
+}
+
+// writeObjectHeaders sets the response headers describing an object.
+func writeObjectHeaders(w http.ResponseWriter, head *client.HeadObjectOutput) {
+	header := w.Header()
+	header.Set("Accept-Ranges", "bytes")
+	header.Set("ETag", quoteETag(head.ETag))
+	header.Set("Last-Modified", head.LastModified.UTC().Format(http.TimeFormat))
+	contentType := head.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	header.Set("Content-Type", contentType)
+	if head.ContentEncoding != "" {
+		header.Set("Content-Encoding", head.ContentEncoding)
+	}
+	if head.ContentLanguage != "" {
+		header.Set("Content-Language", head.ContentLanguage)
+	}
+	if head.VersionId != "" {
+		header.Set("x-amz-version-id", head.VersionId)
+	}
+	if head.ServerSideEncryption != "" {
+		header.Set("x-amz-server-side-encryption", head.ServerSideEncryption)
+	}
+	for name, value := range head.UserMetadata {
+		header.Set("x-amz-meta-"+name, value)
+	}
+}
+
+// userMetadata collects the x-amz-meta-* headers of a request, keyed by the name after the prefix.
+// Repeated headers are joined with commas, as S3 does.
+func userMetadata(header http.Header) map[string]string {
+	var metadata map[string]string
+	for name, values := range header {
+		name = strings.ToLower(name)
+		if !strings.HasPrefix(name, "x-amz-meta-") {
+			continue
+		}
+		if metadata == nil {
+			metadata = make(map[string]string)
+		}
+		metadata[strings.TrimPrefix(name, "x-amz-meta-")] = strings.Join(values, ",")
+	}
+	return metadata
+}
+
+// bodyError converts an error from reading the request body into an S3 error.
+func bodyError(err error) *s3Error {
+	var s3Err *s3Error
+	if errors.As(err, &s3Err) {
+		return s3Err
+	}
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return errEntityTooLarge
+	}
+	return errIncompleteBody
+}
+
+// encodeKey applies the listing encoding type to a key.
+func encodeKey(key, encodingType string) string {
+	if encodingType == "url" {
+		return awsEscape(key, false)
+	}
+	return key
+}
+
+// quoteETag returns the ETag in the quoted form used by S3.
+func quoteETag(etag string) string {
+	if etag == "" || strings.HasPrefix(etag, "\"") {
+		return etag
+	}
+	return "\"" + etag + "\""
+}
+
+// newRequestID returns a random identifier for the x-amz-request-id header.
+func newRequestID() string {
+	var id [8]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return strings.ToUpper(hex.EncodeToString(id[:]))
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package main
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/AcceleratedCloudStorage/acs-sdk-go/client"
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+)
+
+// listingStore serves listings of a fixed set of keys and records each listing request.
+// The other operations are not used by the tests that use it.
+type listingStore struct {
+	objectStore
+	keys     []string
+	requests []client.ListObjectsOptions
+}
+
+func (s *listingStore) ListObjectSummaries(ctx context.Context, bucket string, opts *client.ListObjectsOptions) ([]*pb.ObjectSummary, error) {
+	s.requests = append(s.requests, *opts)
+	keys := append([]string(nil), s.keys...)
+	sort.Strings(keys)
+	var objects []*pb.ObjectSummary
+	for _, key := range keys {
+		if !strings.HasPrefix(key, opts.Prefix) || key <= opts.StartAfter {
+			continue
+		}
+		if opts.MaxKeys > 0 && len(objects) == int(opts.MaxKeys) {
+			break
+		}
+		objects = append(objects, &pb.ObjectSummary{Key: key, Size: 1, Etag: "etag"})
+	}
+	return objects, nil
+}
+
+// list serves a listing request for query against the keys and decodes the result.
+func list(t *testing.T, g *gateway, query string) listBucketResult {
+	t.Helper()
+	w := httptest.NewRecorder()
+	g.serveBucket(w, httptest.NewRequest(http.MethodGet, "/bucket?"+query, nil), "bucket")
+	if w.Code != http.StatusOK {
+		t.Fatalf("listing %q returned %d: %s", query, w.Code, w.Body)
+	}
+	var result listBucketResult
+	if err := xml.Unmarshal(w.Body.Bytes(), &result); err != nil {
+		t.Fatalf("listing %q returned invalid XML: %v", query, err)
+	}
+	return result
+}
+
+// names returns the keys and common prefixes of a listing.
+func names(result listBucketResult) (keys, prefixes []string) {
+	for _, obj := range result.Contents {
+		keys = append(keys, obj.Key)
+	}
+	for _, prefix := range result.CommonPrefixes {
+		prefixes = append(prefixes, prefix.Prefix)
+	}
+	return keys, prefixes
+}
+
+func TestListObjectsRollsUpCommonPrefixes(t *testing.T) {
+	g := &gateway{client: &listingStore{keys: []string{"a.txt", "dir/1", "dir/2", "dir/sub/3", "z.txt"}}}
+	tests := []struct {
+		query    string
+		keys     []string
+		prefixes []string
+	}{
+		{"list-type=2", []string{"a.txt", "dir/1", "dir/2", "dir/sub/3", "z.txt"}, nil},
+		{"list-type=2&delimiter=/", []string{"a.txt", "z.txt"}, []string{"dir/"}},
+		{"list-type=2&delimiter=/&prefix=dir/", []string{"dir/1", "dir/2"}, []string{"dir/sub/"}},
+		{"delimiter=/&prefix=dir/sub/", []string{"dir/sub/3"}, nil},
+		{"list-type=2&delimiter=.", []string{"dir/1", "dir/2", "dir/sub/3"}, []string{"a.", "z."}},
+	}
+
+	for _, tt := range tests {
+		result := list(t, g, tt.query)
+		keys, prefixes := names(result)
+		if !reflect.DeepEqual(keys, tt.keys) || !reflect.DeepEqual(prefixes, tt.prefixes) {
+			t.Errorf("listing %q = %v and prefixes %v; want %v and %v", tt.query, keys, prefixes, tt.keys, tt.prefixes)
+		}
+		if result.IsTruncated {
+			t.Errorf("listing %q is truncated", tt.query)
+		}
+	}
+}
+
+func TestListObjectsPagination(t *testing.T) {
+	g := &gateway{client: &listingStore{keys: []string{"a.txt", "dir/1", "dir/2", "z.txt"}}}
+
+	// Each page counts a common prefix as one entry and resumes after it
+	var keys, prefixes []string
+	query := "list-type=2&delimiter=/&max-keys=1"
+	for pages := 0; ; pages++ {
+		if pages == 3 {
+			t.Fatal("listing did not finish after 3 pages")
+		}
+		result := list(t, g, query)
+		pageKeys, pagePrefixes := names(result)
+		keys, prefixes = append(keys, pageKeys...), append(prefixes, pagePrefixes...)
+		if !result.IsTruncated {
+			break
+		}
+		if result.NextContinuationToken == "" {
+			t.Fatal("truncated listing has no continuation token")
+		}
+		query = "list-type=2&delimiter=/&max-keys=1&continuation-token=" + result.NextContinuationToken
+	}
+	if want := []string{"a.txt", "z.txt"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("paged keys = %v, want %v", keys, want)
+	}
+	if want := []string{"dir/"}; !reflect.DeepEqual(prefixes, want) {
+		t.Errorf("paged prefixes = %v, want %v", prefixes, want)
+	}
+
+	// Version 1 listings page with markers
+	result := list(t, g, "max-keys=2")
+	if !result.IsTruncated || result.NextMarker != "dir/1" {
+		t.Fatalf("first page is truncated = %v with next marker %q, want a marker of dir/1", result.IsTruncated, result.NextMarker)
+	}
+	result = list(t, g, "max-keys=2&marker="+result.NextMarker)
+	if keys, _ := names(result); !reflect.DeepEqual(keys, []string{"dir/2", "z.txt"}) || result.IsTruncated {
+		t.Errorf("second page = %v (truncated %v), want [dir/2 z.txt]", keys, result.IsTruncated)
+	}
+
+	// Malformed continuation tokens are rejected
+	w := httptest.NewRecorder()
+	g.serveBucket(w, httptest.NewRequest(http.MethodGet, "/bucket?list-type=2&continuation-token=%25", nil), "bucket")
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("malformed continuation token returned %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestListObjectsSkipsRolledUpPrefixes(t *testing.T) {
+	store := &listingStore{keys: []string{"b"}}
+	for i := 0; i < 50; i++ {
+		store.keys = append(store.keys, "a/"+strings.Repeat("x", i))
+	}
+	g := &gateway{client: store}
+
+	result := list(t, g, "list-type=2&delimiter=/&max-keys=2")
+	keys, prefixes := names(result)
+	if !reflect.DeepEqual(keys, []string{"b"}) || !reflect.DeepEqual(prefixes, []string{"a/"}) {
+		t.Errorf("listing = %v and prefixes %v; want [b] and [a/]", keys, prefixes)
+	}
+
+	// The second service listing jumps past every key under the rolled up prefix
+	if len(store.requests) != 2 {
+		t.Fatalf("listing made %d service requests, want 2", len(store.requests))
+	}
+	if got, want := store.requests[1].StartAfter, "a/"+afterPrefix; got != want {
+		t.Errorf("second request starts after %q, want %q", got, want)
+	}
+}
+
+func TestPutObjectRejectsBodiesOverBudget(t *testing.T) {
+	g := &gateway{maxObjectSize: 100, buffers: newByteBudget(150)}
+	if !g.buffers.reserve(100) {
+		t.Fatal("reserve() = false, want the budget to have room")
+	}
+
+	// Another request's body is still buffered, so this one does not fit
+	r := httptest.NewRequest(http.MethodPut, "/bucket/key", strings.NewReader(strings.Repeat("x", 80)))
+	w := httptest.NewRecorder()
+	g.serveObject(w, r, "bucket", "key")
+	if w.Code != http.StatusServiceUnavailable || !strings.Contains(w.Body.String(), "SlowDown") {
+		t.Errorf("PutObject over the budget returned %d: %s; want SlowDown", w.Code, w.Body)
+	}
+
+	// Bodies over the per-request cap are rejected outright
+	r = httptest.NewRequest(http.MethodPut, "/bucket/key", strings.NewReader(strings.Repeat("x", 101)))
+	w = httptest.NewRecorder()
+	g.serveObject(w, r, "bucket", "key")
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "EntityTooLarge") {
+		t.Errorf("PutObject over the maximum object size returned %d: %s; want EntityTooLarge", w.Code, w.Body)
+	}
+}
+
+func TestUserMetadata(t *testing.T) {
+	header := http.Header{}
+	header.Set("Content-Type", "text/plain")
+	header.Set("X-Amz-Meta-Owner", "analytics")
+	header.Add("X-Amz-Meta-Labels", "a")
+	header.Add("X-Amz-Meta-Labels", "b")
+
+	want := map[string]string{"owner": "analytics", "labels": "a,b"}
+	if got := userMetadata(header); !reflect.DeepEqual(got, want) {
+		t.Errorf("userMetadata() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+
+// Command acs-gateway serves a subset of the S3 REST API over HTTP and translates
+// each call into ACSClient operations, so that S3-only tooling can use ACS buckets.
+//
+// Supported operations are ListBuckets, CreateBucket, HeadBucket, DeleteBucket,
+// GetBucketLocation, ListObjects (v1 and v2), PutObject, GetObject (with Range),
+// HeadObject, DeleteObject, DeleteObjects and CopyObject. Requests must use
+// path-style addressing and be signed with AWS Signature Version 4 using one of
+// the keys in the keys file, which has the same layout as the credentials file:
+//
+//	gateway:
+//	    access_key_id: GATEWAY_KEY
+//	    secret_access_key: GATEWAY_SECRET
+//
+// Multipart uploads are not supported, so clients should be configured with a
+// multipart threshold above the largest object they upload. PutObject bodies are
+// buffered in memory and limited by -max-object-size (512 MiB by default), and
+// GetObject responses are streamed in 8 MiB windows. Requests whose bodies would
+// take the memory held across all requests past -max-buffered-bytes (2 GiB by
+// default) are rejected with SlowDown so that clients back off and retry.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/AcceleratedCloudStorage/acs-sdk-go/client"
+)
+
+// Memory limits
+const (
+	defaultMaxObjectSize    = 512 * 1024 * 1024      // Largest PutObject body buffered for a single request
+	defaultMaxBufferedBytes = 2 * 1024 * 1024 * 1024 // Object data held in memory across all requests
+)
+
+func main() {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		log.Fatalf("Failed to get home directory: %v", err)
+	}
+
+	listen := flag.String("listen", ":9000", "address to serve the S3 API on")
+	keysFile := flag.String("keys", filepath.Join(homeDir, ".acs", "gateway.yaml"), "file with the access keys accepted by the gateway")
+	region := flag.String("region", "us-east-1", "region of the ACS session")
+	certFile := flag.String("tls-cert", "", "TLS certificate file (serves plain HTTP if empty)")
+	keyFile := flag.String("tls-key", "", "TLS private key file")
+	maxObjectSize := flag.Int64("max-object-size", defaultMaxObjectSize, "largest object accepted by PutObject, in bytes (bodies are buffered in memory)")
+	maxBufferedBytes := flag.Int64("max-buffered-bytes", defaultMaxBufferedBytes, "object data held in memory across all requests, in bytes")
+	flag.Parse()
+	if *maxObjectSize > *maxBufferedBytes {
+		log.Fatalf("-max-object-size (%d) must not exceed -max-buffered-bytes (%d)", *maxObjectSize, *maxBufferedBytes)
+	}
+
+	secrets, err := loadGatewayKeys(*keysFile)
+	if err != nil {
+		log.Fatalf("Failed to load gateway keys: %v", err)
+	}
+
+	acsClient, err := client.NewClient(&client.Session{Region: *region})
+	if err != nil {
+		log.Fatalf("Failed to create ACS client: %v", err)
+	}
+	defer acsClient.Close()
+
+	server := &http.Server{
+		Addr: *listen,
+		Handler: &gateway{
+			client:        acsClient,
+			verifier:      &verifier{secrets: secrets, now: time.Now},
+			maxObjectSize: *maxObjectSize,
+			buffers:       newByteBudget(*maxBufferedBytes),
+		},
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+
+	// Shut down gracefully on interrupt
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Printf("Failed to shut down gateway: %v", err)
+		}
+	}()
+
+	log.Printf("Serving S3 API on %s", *listen)
+	if *certFile != "" {
+		err = server.ListenAndServeTLS(*certFile, *keyFile)
+	} else {
+		err = server.ListenAndServe()
+	}
+	if err != nil && err != http.ErrServerClosed {
+		log.Fatalf("Gateway failed: %v", err)
+	}
+}
@@ -0,0 +1,486 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Signature Version 4 constants
+const (
+	signV4Algorithm       = "AWS4-HMAC-SHA256"
+	signV4ChunkAlgorithm  = "AWS4-HMAC-SHA256-PAYLOAD"
+	unsignedPayload       = "UNSIGNED-PAYLOAD"
+	streamingPayload      = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	streamingUnsignedTail = "STREAMING-UNSIGNED-PAYLOAD-TRAILER"
+	emptySHA256           = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	amzDateFormat         = "20060102T150405Z"
+	maxClockSkew          = 15 * time.Minute
+	maxPresignExpiry      = 7 * 24 * time.Hour
+	maxChunkSize          = 16 * 1024 * 1024 // Largest aws-chunked chunk accepted, well above what SDKs send
+)
+
+// verifier checks AWS Signature Version 4 signatures against locally configured keys.
+type verifier struct {
+	// secrets maps access key IDs to secret access keys
+	secrets map[string]string
+	// now returns the current time
+	now func() time.Time
+}
+
+// signature holds the parsed components of a SigV4 signed request.
+type signature struct {
+	accessKeyID   string
+	date          time.Time
+	amzDate       string
+	scope         string
+	signedHeaders []string
+	signature     string
+	payloadHash   string
+	presigned     bool
+}
+
+// verify authenticates the request, using either the Authorization header or presigned query parameters.
+// On success the request body is replaced with one that verifies the payload as it is read.
+func (v *verifier) verify(r *http.Request) *s3Error {
+	var sig *signature
+	var s3Err *s3Error
+	switch {
+	case r.Header.Get("Authorization") != "":
+		sig, s3Err = parseAuthorizationHeader(r)
+	case r.URL.Query().Get("X-Amz-Algorithm") != "":
+		sig, s3Err = parsePresignedQuery(r)
+	default:
+		return errMissingAuth
+	}
+	if s3Err != nil {
+		return s3Err
+	}
+
+	secret, ok := v.secrets[sig.accessKeyID]
+	if !ok {
+		return errInvalidAccessKeyID
+	}
+
+	now := v.now()
+	if sig.presigned {
+		expires, err := strconv.Atoi(r.URL.Query().Get("X-Amz-Expires"))
+		if err != nil || expires < 0 || time.Duration(expires)*time.Second > maxPresignExpiry {
+			return errMalformedAuth
+		}
+		if now.Before(sig.date.Add(-maxClockSkew)) {
+			return errRequestTimeTooSkewed
+		}
+		if now.After(sig.date.Add(time.Duration(expires) * time.Second)) {
+			return errRequestExpired
+		}
+	} else if d := now.Sub(sig.date); d > maxClockSkew || d < -maxClockSkew {
+		return errRequestTimeTooSkewed
+	}
+
+	canonicalRequest, err := buildCanonicalRequest(r, sig)
+	if err != nil {
+		return errMalformedAuth
+	}
+
+	key := signingKey(secret, sig.scope)
+	expected := hex.EncodeToString(hmacSHA256(key, stringToSign(sig.amzDate, sig.scope, canonicalRequest)))
+	if !hmac.Equal([]byte(expected), []byte(sig.signature)) {
+		return errSignatureMismatch
+	}
+
+	// Verify the payload lazily as handlers consume the body
+	switch sig.payloadHash {
+	case unsignedPayload:
+	case streamingPayload:
+		r.Body = newChunkedReader(r.Body, &chunkSigner{
+			key:           key,
+			amzDate:       sig.amzDate,
+			scope:         sig.scope,
+			prevSignature: sig.signature,
+		})
+	case streamingUnsignedTail:
+		r.Body = newChunkedReader(r.Body, nil)
+	default:
+		if len(sig.payloadHash) != sha256.Size*2 {
+			return errNotImplemented
+		}
+		r.Body = &hashingReader{body: r.Body, hash: sha256.New(), expected: sig.payloadHash}
+	}
+
+	return nil
+}
+
+// parseAuthorizationHeader parses a header of the form
+// "AWS4-HMAC-SHA256 Credential=AKID/date/region/s3/aws4_request, SignedHeaders=a;b, Signature=hex".
+func parseAuthorizationHeader(r *http.Request) (*signature, *s3Error) {
+	auth := r.Header.Get("Authorization")
+	algorithm, rest, ok := strings.Cut(auth, " ")
+	if !ok || algorithm != signV4Algorithm {
+		return nil, errNotImplemented
+	}
+
+	fields := make(map[string]string)
+	for _, part := range strings.Split(rest, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, errMalformedAuth
+		}
+		fields[name] = value
+	}
+
+	sig := &signature{
+		signature:   fields["Signature"],
+		payloadHash: r.Header.Get("X-Amz-Content-Sha256"),
+	}
+	if sig.payloadHash == "" {
+		return nil, &s3Error{"InvalidRequest", "Missing required header for this request: x-amz-content-sha256", http.StatusBadRequest}
+	}
+
+	amzDate := r.Header.Get("X-Amz-Date")
+	if amzDate == "" {
+		// Fall back to the Date header, which is signed in its original form
+		date, err := http.ParseTime(r.Header.Get("Date"))
+		if err != nil {
+			return nil, errMissingDate
+		}
+		amzDate = date.UTC().Format(amzDateFormat)
+	}
+
+	if s3Err := sig.setCredential(fields["Credential"], amzDate); s3Err != nil {
+		return nil, s3Err
+	}
+	if s3Err := sig.setSignedHeaders(fields["SignedHeaders"]); s3Err != nil {
+		return nil, s3Err
+	}
+	if sig.signature == "" {
+		return nil, errMalformedAuth
+	}
+
+	return sig, nil
+}
+
+// parsePresignedQuery parses the X-Amz-* query parameters of a presigned URL.
+func parsePresignedQuery(r *http.Request) (*signature, *s3Error) {
+	query := r.URL.Query()
+	if query.Get("X-Amz-Algorithm") != signV4Algorithm {
+		return nil, errNotImplemented
+	}
+
+	sig := &signature{
+		signature:   query.Get("X-Amz-Signature"),
+		payloadHash: query.Get("X-Amz-Content-Sha256"),
+		presigned:   true,
+	}
+	if sig.payloadHash == "" {
+		sig.payloadHash = unsignedPayload
+	}
+
+	if s3Err := sig.setCredential(query.Get("X-Amz-Credential"), query.Get("X-Amz-Date")); s3Err != nil {
+		return nil, s3Err
+	}
+	if s3Err := sig.setSignedHeaders(query.Get("X-Amz-SignedHeaders")); s3Err != nil {
+		return nil, s3Err
+	}
+	if sig.signature == "" {
+		return nil, errMalformedAuth
+	}
+
+	return sig, nil
+}
+
+// setCredential parses "AKID/yyyymmdd/region/s3/aws4_request" and the request timestamp.
+func (sig *signature) setCredential(credential, amzDate string) *s3Error {
+	date, err := time.Parse(amzDateFormat, amzDate)
+	if err != nil {
+		return errMissingDate
+	}
+
+	parts := strings.Split(credential, "/")
+	if len(parts) != 5 || parts[3] != "s3" || parts[4] != "aws4_request" {
+		return errMalformedAuth
+	}
+	if parts[1] != amzDate[:8] {
+		return errMalformedAuth
+	}
+
+	sig.accessKeyID = parts[0]
+	sig.date = date
+	sig.amzDate = amzDate
+	sig.scope = strings.Join(parts[1:], "/")
+	return nil
+}
+
+// setSignedHeaders parses the semicolon separated list of signed header names.
+func (sig *signature) setSignedHeaders(signedHeaders string) *s3Error {
+	if signedHeaders == "" {
+		return errMalformedAuth
+	}
+
+	sig.signedHeaders = strings.Split(signedHeaders, ";")
+	for _, name := range sig.signedHeaders {
+		if name == "host" {
+			return nil
+		}
+	}
+	return errMalformedAuth
+}
+
+// buildCanonicalRequest builds the SigV4 canonical request for r.
+func buildCanonicalRequest(r *http.Request, sig *signature) (string, error) {
+	query, err := url.ParseQuery(r.URL.RawQuery)
+	if err != nil {
+		return "", err
+	}
+
+	// Parameters are sorted by encoded name, then by encoded value
+	var params [][2]string
+	for name, values := range query {
+		if sig.presigned && name == "X-Amz-Signature" {
+			continue
+		}
+		for _, value := range values {
+			params = append(params, [2]string{awsEscape(name, true), awsEscape(value, true)})
+		}
+	}
+	sort.Slice(params, func(i, j int) bool {
+		if params[i][0] != params[j][0] {
+			return params[i][0] < params[j][0]
+		}
+		return params[i][1] < params[j][1]
+	})
+	encoded := make([]string, len(params))
+	for i, param := range params {
+		encoded[i] = param[0] + "=" + param[1]
+	}
+
+	var headers strings.Builder
+	for _, name := range sig.signedHeaders {
+		var value string
+		if name == "host" {
+			value = r.Host
+		} else {
+			var values []string
+			for _, v := range r.Header.Values(name) {
+				values = append(values, strings.Join(strings.Fields(v), " "))
+			}
+			value = strings.Join(values, ",")
+		}
+		headers.WriteString(name + ":" + value + "\n")
+	}
+
+	canonicalURI := awsEscape(r.URL.Path, false)
+	if canonicalURI == "" {
+		canonicalURI = "/"
+	}
+
+	return strings.Join([]string{
+		r.Method,
+		canonicalURI,
+		strings.Join(encoded, "&"),
+		headers.String(),
+		strings.Join(sig.signedHeaders, ";"),
+		sig.payloadHash,
+	}, "\n"), nil
+}
+
+// stringToSign builds the SigV4 string to sign for a canonical request.
+func stringToSign(amzDate, scope, canonicalRequest string) string {
+	sum := sha256.Sum256([]byte(canonicalRequest))
+	return strings.Join([]string{
+		signV4Algorithm,
+		amzDate,
+		scope,
+		hex.EncodeToString(sum[:]),
+	}, "\n")
+}
+
+// signingKey derives the SigV4 signing key for a credential scope.
+func signingKey(secret, scope string) []byte {
+	key := []byte("AWS4" + secret)
+	for _, part := range strings.Split(scope, "/") {
+		key = hmacSHA256(key, part)
+	}
+	return key
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// awsEscape percent-encodes s as required by SigV4: every byte except the
+// unreserved characters is encoded, and '/' is kept unless encodeSlash is set.
+func awsEscape(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// hashingReader verifies the SHA-256 of a body against the signed payload hash once it is fully read.
+type hashingReader struct {
+	body     io.ReadCloser
+	hash     hash.Hash
+	expected string
+}
+
+func (h *hashingReader) Read(p []byte) (int, error) {
+	n, err := h.body.Read(p)
+	h.hash.Write(p[:n])
+	if err == io.EOF && hex.EncodeToString(h.hash.Sum(nil)) != h.expected {
+		return n, errContentSHA256
+	}
+	return n, err
+}
+
+func (h *hashingReader) Close() error {
+	return h.body.Close()
+}
+
+// chunkSigner verifies the signature chain of an aws-chunked payload.
+type chunkSigner struct {
+	key           []byte
+	amzDate       string
+	scope         string
+	prevSignature string
+}
+
+// verify checks the signature of one chunk and advances the chain.
+func (s *chunkSigner) verify(data []byte, chunkSignature string) bool {
+	sum := sha256.Sum256(data)
+	toSign := strings.Join([]string{
+		signV4ChunkAlgorithm,
+		s.amzDate,
+		s.scope,
+		s.prevSignature,
+		emptySHA256,
+		hex.EncodeToString(sum[:]),
+	}, "\n")
+
+	expected := hex.EncodeToString(hmacSHA256(s.key, toSign))
+	if !hmac.Equal([]byte(expected), []byte(chunkSignature)) {
+		return false
+	}
+	s.prevSignature = expected
+	return true
+}
+
+// chunkedReader decodes an aws-chunked request body.
+// Each chunk is "hex-size[;chunk-signature=sig]\r\n" followed by the data and "\r\n",
+// terminated by a zero-size chunk and optional trailing headers.
+type chunkedReader struct {
+	body   io.ReadCloser
+	reader *bufio.Reader
+	signer *chunkSigner
+	buf    []byte // Reused for every chunk, at most maxChunkSize bytes
+	chunk  []byte
+	err    error
+}
+
+func newChunkedReader(body io.ReadCloser, signer *chunkSigner) *chunkedReader {
+	return &chunkedReader{
+		body:   body,
+		reader: bufio.NewReader(body),
+		signer: signer,
+	}
+}
+
+func (c *chunkedReader) Read(p []byte) (int, error) {
+	for len(c.chunk) == 0 {
+		if c.err != nil {
+			return 0, c.err
+		}
+		c.err = c.nextChunk()
+	}
+
+	n := copy(p, c.chunk)
+	c.chunk = c.chunk[n:]
+	return n, nil
+}
+
+func (c *chunkedReader) Close() error {
+	return c.body.Close()
+}
+
+// nextChunk reads and verifies the next chunk. It returns io.EOF after the final chunk.
+func (c *chunkedReader) nextChunk() error {
+	header, err := c.readLine()
+	if err != nil {
+		return errIncompleteBody
+	}
+
+	sizeField, extension, _ := strings.Cut(header, ";")
+	size, err := strconv.ParseInt(sizeField, 16, 64)
+	if err != nil || size < 0 {
+		return errIncompleteBody
+	}
+	if size > maxChunkSize {
+		return errEntityTooLarge
+	}
+
+	// The previous chunk has been read in full, so its buffer can be reused
+	if int64(cap(c.buf)) < size {
+		c.buf = make([]byte, size)
+	}
+	data := c.buf[:size]
+	if _, err := io.ReadFull(c.reader, data); err != nil {
+		return errIncompleteBody
+	}
+
+	if c.signer != nil {
+		chunkSignature, ok := strings.CutPrefix(extension, "chunk-signature=")
+		if !ok || !c.signer.verify(data, chunkSignature) {
+			return errSignatureMismatch
+		}
+	}
+
+	if size == 0 {
+		// Consume trailing headers (if any) up to the terminating blank line
+		for {
+			line, err := c.readLine()
+			if err != nil || line == "" {
+				return io.EOF
+			}
+		}
+	}
+
+	if line, err := c.readLine(); err != nil || line != "" {
+		return errIncompleteBody
+	}
+
+	c.chunk = data
+	return nil
+}
+
+// readLine reads a CRLF terminated line without the line ending.
+func (c *chunkedReader) readLine() (string, error) {
+	line, err := c.reader.ReadBytes('\n')
+	if err != nil {
+		return "", err
+	}
+	return string(bytes.TrimRight(line, "\r\n")), nil
+}
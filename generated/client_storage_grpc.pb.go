@@ -19,20 +19,49 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ObjectStorageCache_CreateBucket_FullMethodName  = "/proto.ObjectStorageCache/CreateBucket"
-	ObjectStorageCache_DeleteBucket_FullMethodName  = "/proto.ObjectStorageCache/DeleteBucket"
-	ObjectStorageCache_ListBuckets_FullMethodName   = "/proto.ObjectStorageCache/ListBuckets"
-	ObjectStorageCache_HeadBucket_FullMethodName    = "/proto.ObjectStorageCache/HeadBucket"
-	ObjectStorageCache_PutObject_FullMethodName     = "/proto.ObjectStorageCache/PutObject"
-	ObjectStorageCache_GetObject_FullMethodName     = "/proto.ObjectStorageCache/GetObject"
-	ObjectStorageCache_DeleteObject_FullMethodName  = "/proto.ObjectStorageCache/DeleteObject"
-	ObjectStorageCache_DeleteObjects_FullMethodName = "/proto.ObjectStorageCache/DeleteObjects"
-	ObjectStorageCache_CopyObject_FullMethodName    = "/proto.ObjectStorageCache/CopyObject"
-	ObjectStorageCache_HeadObject_FullMethodName    = "/proto.ObjectStorageCache/HeadObject"
-	ObjectStorageCache_ListObjects_FullMethodName   = "/proto.ObjectStorageCache/ListObjects"
-	ObjectStorageCache_Authenticate_FullMethodName  = "/proto.ObjectStorageCache/Authenticate"
-	ObjectStorageCache_RotateKey_FullMethodName     = "/proto.ObjectStorageCache/RotateKey"
-	ObjectStorageCache_ShareBucket_FullMethodName   = "/proto.ObjectStorageCache/ShareBucket"
+	ObjectStorageCache_CreateBucket_FullMethodName               = "/proto.ObjectStorageCache/CreateBucket"
+	ObjectStorageCache_DeleteBucket_FullMethodName               = "/proto.ObjectStorageCache/DeleteBucket"
+	ObjectStorageCache_ListBuckets_FullMethodName                = "/proto.ObjectStorageCache/ListBuckets"
+	ObjectStorageCache_HeadBucket_FullMethodName                 = "/proto.ObjectStorageCache/HeadBucket"
+	ObjectStorageCache_PutObject_FullMethodName                  = "/proto.ObjectStorageCache/PutObject"
+	ObjectStorageCache_GetObject_FullMethodName                  = "/proto.ObjectStorageCache/GetObject"
+	ObjectStorageCache_DeleteObject_FullMethodName               = "/proto.ObjectStorageCache/DeleteObject"
+	ObjectStorageCache_DeleteObjects_FullMethodName              = "/proto.ObjectStorageCache/DeleteObjects"
+	ObjectStorageCache_CopyObject_FullMethodName                 = "/proto.ObjectStorageCache/CopyObject"
+	ObjectStorageCache_HeadObject_FullMethodName                 = "/proto.ObjectStorageCache/HeadObject"
+	ObjectStorageCache_HeadObjects_FullMethodName                = "/proto.ObjectStorageCache/HeadObjects"
+	ObjectStorageCache_GetObjects_FullMethodName                 = "/proto.ObjectStorageCache/GetObjects"
+	ObjectStorageCache_ListObjects_FullMethodName                = "/proto.ObjectStorageCache/ListObjects"
+	ObjectStorageCache_ListObjectVersions_FullMethodName         = "/proto.ObjectStorageCache/ListObjectVersions"
+	ObjectStorageCache_SelectObjectContent_FullMethodName        = "/proto.ObjectStorageCache/SelectObjectContent"
+	ObjectStorageCache_CreateMultipartUpload_FullMethodName      = "/proto.ObjectStorageCache/CreateMultipartUpload"
+	ObjectStorageCache_UploadPart_FullMethodName                 = "/proto.ObjectStorageCache/UploadPart"
+	ObjectStorageCache_UploadPartCopy_FullMethodName             = "/proto.ObjectStorageCache/UploadPartCopy"
+	ObjectStorageCache_CompleteMultipartUpload_FullMethodName    = "/proto.ObjectStorageCache/CompleteMultipartUpload"
+	ObjectStorageCache_AbortMultipartUpload_FullMethodName       = "/proto.ObjectStorageCache/AbortMultipartUpload"
+	ObjectStorageCache_PutBucketVersioning_FullMethodName        = "/proto.ObjectStorageCache/PutBucketVersioning"
+	ObjectStorageCache_GetBucketVersioning_FullMethodName        = "/proto.ObjectStorageCache/GetBucketVersioning"
+	ObjectStorageCache_PutBucketLifecycle_FullMethodName         = "/proto.ObjectStorageCache/PutBucketLifecycle"
+	ObjectStorageCache_GetBucketLifecycle_FullMethodName         = "/proto.ObjectStorageCache/GetBucketLifecycle"
+	ObjectStorageCache_DeleteBucketLifecycle_FullMethodName      = "/proto.ObjectStorageCache/DeleteBucketLifecycle"
+	ObjectStorageCache_PutObjectTagging_FullMethodName           = "/proto.ObjectStorageCache/PutObjectTagging"
+	ObjectStorageCache_GetObjectTagging_FullMethodName           = "/proto.ObjectStorageCache/GetObjectTagging"
+	ObjectStorageCache_DeleteObjectTagging_FullMethodName        = "/proto.ObjectStorageCache/DeleteObjectTagging"
+	ObjectStorageCache_PutObjectLockConfiguration_FullMethodName = "/proto.ObjectStorageCache/PutObjectLockConfiguration"
+	ObjectStorageCache_GetObjectLockConfiguration_FullMethodName = "/proto.ObjectStorageCache/GetObjectLockConfiguration"
+	ObjectStorageCache_PutObjectRetention_FullMethodName         = "/proto.ObjectStorageCache/PutObjectRetention"
+	ObjectStorageCache_GetObjectRetention_FullMethodName         = "/proto.ObjectStorageCache/GetObjectRetention"
+	ObjectStorageCache_PutObjectLegalHold_FullMethodName         = "/proto.ObjectStorageCache/PutObjectLegalHold"
+	ObjectStorageCache_GetObjectLegalHold_FullMethodName         = "/proto.ObjectStorageCache/GetObjectLegalHold"
+	ObjectStorageCache_PutBucketPolicy_FullMethodName            = "/proto.ObjectStorageCache/PutBucketPolicy"
+	ObjectStorageCache_GetBucketPolicy_FullMethodName            = "/proto.ObjectStorageCache/GetBucketPolicy"
+	ObjectStorageCache_DeleteBucketPolicy_FullMethodName         = "/proto.ObjectStorageCache/DeleteBucketPolicy"
+	ObjectStorageCache_PutBucketEncryption_FullMethodName        = "/proto.ObjectStorageCache/PutBucketEncryption"
+	ObjectStorageCache_GetBucketEncryption_FullMethodName        = "/proto.ObjectStorageCache/GetBucketEncryption"
+	ObjectStorageCache_DeleteBucketEncryption_FullMethodName     = "/proto.ObjectStorageCache/DeleteBucketEncryption"
+	ObjectStorageCache_Authenticate_FullMethodName               = "/proto.ObjectStorageCache/Authenticate"
+	ObjectStorageCache_RotateKey_FullMethodName                  = "/proto.ObjectStorageCache/RotateKey"
+	ObjectStorageCache_ShareBucket_FullMethodName                = "/proto.ObjectStorageCache/ShareBucket"
 )
 
 // ObjectStorageCacheClient is the client API for ObjectStorageCache service.
@@ -51,7 +80,43 @@ type ObjectStorageCacheClient interface {
 	DeleteObjects(ctx context.Context, in *DeleteObjectsRequest, opts ...grpc.CallOption) (*DeleteObjectsResponse, error)
 	CopyObject(ctx context.Context, in *CopyObjectRequest, opts ...grpc.CallOption) (*CopyObjectResponse, error)
 	HeadObject(ctx context.Context, in *HeadObjectRequest, opts ...grpc.CallOption) (*HeadObjectResponse, error)
+	HeadObjects(ctx context.Context, in *HeadObjectsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HeadObjectsResponse], error)
+	GetObjects(ctx context.Context, in *GetObjectsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetObjectsResponse], error)
 	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListObjectsResponse], error)
+	ListObjectVersions(ctx context.Context, in *ListObjectVersionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListObjectVersionsResponse], error)
+	SelectObjectContent(ctx context.Context, in *SelectObjectContentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SelectObjectContentResponse], error)
+	// Multipart operations
+	CreateMultipartUpload(ctx context.Context, in *CreateMultipartUploadRequest, opts ...grpc.CallOption) (*CreateMultipartUploadResponse, error)
+	UploadPart(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadPartRequest, UploadPartResponse], error)
+	UploadPartCopy(ctx context.Context, in *UploadPartCopyRequest, opts ...grpc.CallOption) (*UploadPartCopyResponse, error)
+	CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...grpc.CallOption) (*CompleteMultipartUploadResponse, error)
+	AbortMultipartUpload(ctx context.Context, in *AbortMultipartUploadRequest, opts ...grpc.CallOption) (*AbortMultipartUploadResponse, error)
+	// Versioning operations
+	PutBucketVersioning(ctx context.Context, in *PutBucketVersioningRequest, opts ...grpc.CallOption) (*PutBucketVersioningResponse, error)
+	GetBucketVersioning(ctx context.Context, in *GetBucketVersioningRequest, opts ...grpc.CallOption) (*GetBucketVersioningResponse, error)
+	// Lifecycle operations
+	PutBucketLifecycle(ctx context.Context, in *PutBucketLifecycleRequest, opts ...grpc.CallOption) (*PutBucketLifecycleResponse, error)
+	GetBucketLifecycle(ctx context.Context, in *GetBucketLifecycleRequest, opts ...grpc.CallOption) (*GetBucketLifecycleResponse, error)
+	DeleteBucketLifecycle(ctx context.Context, in *DeleteBucketLifecycleRequest, opts ...grpc.CallOption) (*DeleteBucketLifecycleResponse, error)
+	// Tagging operations
+	PutObjectTagging(ctx context.Context, in *PutObjectTaggingRequest, opts ...grpc.CallOption) (*PutObjectTaggingResponse, error)
+	GetObjectTagging(ctx context.Context, in *GetObjectTaggingRequest, opts ...grpc.CallOption) (*GetObjectTaggingResponse, error)
+	DeleteObjectTagging(ctx context.Context, in *DeleteObjectTaggingRequest, opts ...grpc.CallOption) (*DeleteObjectTaggingResponse, error)
+	// Object lock operations
+	PutObjectLockConfiguration(ctx context.Context, in *PutObjectLockConfigurationRequest, opts ...grpc.CallOption) (*PutObjectLockConfigurationResponse, error)
+	GetObjectLockConfiguration(ctx context.Context, in *GetObjectLockConfigurationRequest, opts ...grpc.CallOption) (*GetObjectLockConfigurationResponse, error)
+	PutObjectRetention(ctx context.Context, in *PutObjectRetentionRequest, opts ...grpc.CallOption) (*PutObjectRetentionResponse, error)
+	GetObjectRetention(ctx context.Context, in *GetObjectRetentionRequest, opts ...grpc.CallOption) (*GetObjectRetentionResponse, error)
+	PutObjectLegalHold(ctx context.Context, in *PutObjectLegalHoldRequest, opts ...grpc.CallOption) (*PutObjectLegalHoldResponse, error)
+	GetObjectLegalHold(ctx context.Context, in *GetObjectLegalHoldRequest, opts ...grpc.CallOption) (*GetObjectLegalHoldResponse, error)
+	// Access policy operations
+	PutBucketPolicy(ctx context.Context, in *PutBucketPolicyRequest, opts ...grpc.CallOption) (*PutBucketPolicyResponse, error)
+	GetBucketPolicy(ctx context.Context, in *GetBucketPolicyRequest, opts ...grpc.CallOption) (*GetBucketPolicyResponse, error)
+	DeleteBucketPolicy(ctx context.Context, in *DeleteBucketPolicyRequest, opts ...grpc.CallOption) (*DeleteBucketPolicyResponse, error)
+	// Encryption operations
+	PutBucketEncryption(ctx context.Context, in *PutBucketEncryptionRequest, opts ...grpc.CallOption) (*PutBucketEncryptionResponse, error)
+	GetBucketEncryption(ctx context.Context, in *GetBucketEncryptionRequest, opts ...grpc.CallOption) (*GetBucketEncryptionResponse, error)
+	DeleteBucketEncryption(ctx context.Context, in *DeleteBucketEncryptionRequest, opts ...grpc.CallOption) (*DeleteBucketEncryptionResponse, error)
 	// Configuration operations
 	Authenticate(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error)
 	RotateKey(ctx context.Context, in *RotateKeyRequest, opts ...grpc.CallOption) (*RotateKeyResponse, error)
@@ -178,9 +243,47 @@ func (c *objectStorageCacheClient) HeadObject(ctx context.Context, in *HeadObjec
 	return out, nil
 }
 
+func (c *objectStorageCacheClient) HeadObjects(ctx context.Context, in *HeadObjectsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[HeadObjectsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ObjectStorageCache_ServiceDesc.Streams[2], ObjectStorageCache_HeadObjects_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[HeadObjectsRequest, HeadObjectsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ObjectStorageCache_HeadObjectsClient = grpc.ServerStreamingClient[HeadObjectsResponse]
+
+func (c *objectStorageCacheClient) GetObjects(ctx context.Context, in *GetObjectsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetObjectsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ObjectStorageCache_ServiceDesc.Streams[3], ObjectStorageCache_GetObjects_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetObjectsRequest, GetObjectsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ObjectStorageCache_GetObjectsClient = grpc.ServerStreamingClient[GetObjectsResponse]
+
 func (c *objectStorageCacheClient) ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListObjectsResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &ObjectStorageCache_ServiceDesc.Streams[2], ObjectStorageCache_ListObjects_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &ObjectStorageCache_ServiceDesc.Streams[4], ObjectStorageCache_ListObjects_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -197,6 +300,297 @@ func (c *objectStorageCacheClient) ListObjects(ctx context.Context, in *ListObje
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ObjectStorageCache_ListObjectsClient = grpc.ServerStreamingClient[ListObjectsResponse]
 
+func (c *objectStorageCacheClient) ListObjectVersions(ctx context.Context, in *ListObjectVersionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ListObjectVersionsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ObjectStorageCache_ServiceDesc.Streams[5], ObjectStorageCache_ListObjectVersions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListObjectVersionsRequest, ListObjectVersionsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ObjectStorageCache_ListObjectVersionsClient = grpc.ServerStreamingClient[ListObjectVersionsResponse]
+
+func (c *objectStorageCacheClient) SelectObjectContent(ctx context.Context, in *SelectObjectContentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SelectObjectContentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ObjectStorageCache_ServiceDesc.Streams[6], ObjectStorageCache_SelectObjectContent_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SelectObjectContentRequest, SelectObjectContentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ObjectStorageCache_SelectObjectContentClient = grpc.ServerStreamingClient[SelectObjectContentResponse]
+
+func (c *objectStorageCacheClient) CreateMultipartUpload(ctx context.Context, in *CreateMultipartUploadRequest, opts ...grpc.CallOption) (*CreateMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_CreateMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) UploadPart(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadPartRequest, UploadPartResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ObjectStorageCache_ServiceDesc.Streams[7], ObjectStorageCache_UploadPart_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadPartRequest, UploadPartResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ObjectStorageCache_UploadPartClient = grpc.ClientStreamingClient[UploadPartRequest, UploadPartResponse]
+
+func (c *objectStorageCacheClient) UploadPartCopy(ctx context.Context, in *UploadPartCopyRequest, opts ...grpc.CallOption) (*UploadPartCopyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadPartCopyResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_UploadPartCopy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...grpc.CallOption) (*CompleteMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_CompleteMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) AbortMultipartUpload(ctx context.Context, in *AbortMultipartUploadRequest, opts ...grpc.CallOption) (*AbortMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbortMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_AbortMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) PutBucketVersioning(ctx context.Context, in *PutBucketVersioningRequest, opts ...grpc.CallOption) (*PutBucketVersioningResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutBucketVersioningResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_PutBucketVersioning_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) GetBucketVersioning(ctx context.Context, in *GetBucketVersioningRequest, opts ...grpc.CallOption) (*GetBucketVersioningResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBucketVersioningResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_GetBucketVersioning_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) PutBucketLifecycle(ctx context.Context, in *PutBucketLifecycleRequest, opts ...grpc.CallOption) (*PutBucketLifecycleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_PutBucketLifecycle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) GetBucketLifecycle(ctx context.Context, in *GetBucketLifecycleRequest, opts ...grpc.CallOption) (*GetBucketLifecycleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_GetBucketLifecycle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) DeleteBucketLifecycle(ctx context.Context, in *DeleteBucketLifecycleRequest, opts ...grpc.CallOption) (*DeleteBucketLifecycleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBucketLifecycleResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_DeleteBucketLifecycle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) PutObjectTagging(ctx context.Context, in *PutObjectTaggingRequest, opts ...grpc.CallOption) (*PutObjectTaggingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutObjectTaggingResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_PutObjectTagging_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) GetObjectTagging(ctx context.Context, in *GetObjectTaggingRequest, opts ...grpc.CallOption) (*GetObjectTaggingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetObjectTaggingResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_GetObjectTagging_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) DeleteObjectTagging(ctx context.Context, in *DeleteObjectTaggingRequest, opts ...grpc.CallOption) (*DeleteObjectTaggingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteObjectTaggingResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_DeleteObjectTagging_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) PutObjectLockConfiguration(ctx context.Context, in *PutObjectLockConfigurationRequest, opts ...grpc.CallOption) (*PutObjectLockConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutObjectLockConfigurationResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_PutObjectLockConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) GetObjectLockConfiguration(ctx context.Context, in *GetObjectLockConfigurationRequest, opts ...grpc.CallOption) (*GetObjectLockConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetObjectLockConfigurationResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_GetObjectLockConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) PutObjectRetention(ctx context.Context, in *PutObjectRetentionRequest, opts ...grpc.CallOption) (*PutObjectRetentionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutObjectRetentionResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_PutObjectRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) GetObjectRetention(ctx context.Context, in *GetObjectRetentionRequest, opts ...grpc.CallOption) (*GetObjectRetentionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetObjectRetentionResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_GetObjectRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) PutObjectLegalHold(ctx context.Context, in *PutObjectLegalHoldRequest, opts ...grpc.CallOption) (*PutObjectLegalHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutObjectLegalHoldResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_PutObjectLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) GetObjectLegalHold(ctx context.Context, in *GetObjectLegalHoldRequest, opts ...grpc.CallOption) (*GetObjectLegalHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetObjectLegalHoldResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_GetObjectLegalHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) PutBucketPolicy(ctx context.Context, in *PutBucketPolicyRequest, opts ...grpc.CallOption) (*PutBucketPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutBucketPolicyResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_PutBucketPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) GetBucketPolicy(ctx context.Context, in *GetBucketPolicyRequest, opts ...grpc.CallOption) (*GetBucketPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBucketPolicyResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_GetBucketPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) DeleteBucketPolicy(ctx context.Context, in *DeleteBucketPolicyRequest, opts ...grpc.CallOption) (*DeleteBucketPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBucketPolicyResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_DeleteBucketPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) PutBucketEncryption(ctx context.Context, in *PutBucketEncryptionRequest, opts ...grpc.CallOption) (*PutBucketEncryptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PutBucketEncryptionResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_PutBucketEncryption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) GetBucketEncryption(ctx context.Context, in *GetBucketEncryptionRequest, opts ...grpc.CallOption) (*GetBucketEncryptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBucketEncryptionResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_GetBucketEncryption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *objectStorageCacheClient) DeleteBucketEncryption(ctx context.Context, in *DeleteBucketEncryptionRequest, opts ...grpc.CallOption) (*DeleteBucketEncryptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBucketEncryptionResponse)
+	err := c.cc.Invoke(ctx, ObjectStorageCache_DeleteBucketEncryption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *objectStorageCacheClient) Authenticate(ctx context.Context, in *AuthRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AuthResponse)
@@ -243,7 +637,43 @@ type ObjectStorageCacheServer interface {
 	DeleteObjects(context.Context, *DeleteObjectsRequest) (*DeleteObjectsResponse, error)
 	CopyObject(context.Context, *CopyObjectRequest) (*CopyObjectResponse, error)
 	HeadObject(context.Context, *HeadObjectRequest) (*HeadObjectResponse, error)
+	HeadObjects(*HeadObjectsRequest, grpc.ServerStreamingServer[HeadObjectsResponse]) error
+	GetObjects(*GetObjectsRequest, grpc.ServerStreamingServer[GetObjectsResponse]) error
 	ListObjects(*ListObjectsRequest, grpc.ServerStreamingServer[ListObjectsResponse]) error
+	ListObjectVersions(*ListObjectVersionsRequest, grpc.ServerStreamingServer[ListObjectVersionsResponse]) error
+	SelectObjectContent(*SelectObjectContentRequest, grpc.ServerStreamingServer[SelectObjectContentResponse]) error
+	// Multipart operations
+	CreateMultipartUpload(context.Context, *CreateMultipartUploadRequest) (*CreateMultipartUploadResponse, error)
+	UploadPart(grpc.ClientStreamingServer[UploadPartRequest, UploadPartResponse]) error
+	UploadPartCopy(context.Context, *UploadPartCopyRequest) (*UploadPartCopyResponse, error)
+	CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*CompleteMultipartUploadResponse, error)
+	AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*AbortMultipartUploadResponse, error)
+	// Versioning operations
+	PutBucketVersioning(context.Context, *PutBucketVersioningRequest) (*PutBucketVersioningResponse, error)
+	GetBucketVersioning(context.Context, *GetBucketVersioningRequest) (*GetBucketVersioningResponse, error)
+	// Lifecycle operations
+	PutBucketLifecycle(context.Context, *PutBucketLifecycleRequest) (*PutBucketLifecycleResponse, error)
+	GetBucketLifecycle(context.Context, *GetBucketLifecycleRequest) (*GetBucketLifecycleResponse, error)
+	DeleteBucketLifecycle(context.Context, *DeleteBucketLifecycleRequest) (*DeleteBucketLifecycleResponse, error)
+	// Tagging operations
+	PutObjectTagging(context.Context, *PutObjectTaggingRequest) (*PutObjectTaggingResponse, error)
+	GetObjectTagging(context.Context, *GetObjectTaggingRequest) (*GetObjectTaggingResponse, error)
+	DeleteObjectTagging(context.Context, *DeleteObjectTaggingRequest) (*DeleteObjectTaggingResponse, error)
+	// Object lock operations
+	PutObjectLockConfiguration(context.Context, *PutObjectLockConfigurationRequest) (*PutObjectLockConfigurationResponse, error)
+	GetObjectLockConfiguration(context.Context, *GetObjectLockConfigurationRequest) (*GetObjectLockConfigurationResponse, error)
+	PutObjectRetention(context.Context, *PutObjectRetentionRequest) (*PutObjectRetentionResponse, error)
+	GetObjectRetention(context.Context, *GetObjectRetentionRequest) (*GetObjectRetentionResponse, error)
+	PutObjectLegalHold(context.Context, *PutObjectLegalHoldRequest) (*PutObjectLegalHoldResponse, error)
+	GetObjectLegalHold(context.Context, *GetObjectLegalHoldRequest) (*GetObjectLegalHoldResponse, error)
+	// Access policy operations
+	PutBucketPolicy(context.Context, *PutBucketPolicyRequest) (*PutBucketPolicyResponse, error)
+	GetBucketPolicy(context.Context, *GetBucketPolicyRequest) (*GetBucketPolicyResponse, error)
+	DeleteBucketPolicy(context.Context, *DeleteBucketPolicyRequest) (*DeleteBucketPolicyResponse, error)
+	// Encryption operations
+	PutBucketEncryption(context.Context, *PutBucketEncryptionRequest) (*PutBucketEncryptionResponse, error)
+	GetBucketEncryption(context.Context, *GetBucketEncryptionRequest) (*GetBucketEncryptionResponse, error)
+	DeleteBucketEncryption(context.Context, *DeleteBucketEncryptionRequest) (*DeleteBucketEncryptionResponse, error)
 	// Configuration operations
 	Authenticate(context.Context, *AuthRequest) (*AuthResponse, error)
 	RotateKey(context.Context, *RotateKeyRequest) (*RotateKeyResponse, error)
@@ -288,211 +718,781 @@ func (UnimplementedObjectStorageCacheServer) CopyObject(context.Context, *CopyOb
 func (UnimplementedObjectStorageCacheServer) HeadObject(context.Context, *HeadObjectRequest) (*HeadObjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HeadObject not implemented")
 }
+func (UnimplementedObjectStorageCacheServer) HeadObjects(*HeadObjectsRequest, grpc.ServerStreamingServer[HeadObjectsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method HeadObjects not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) GetObjects(*GetObjectsRequest, grpc.ServerStreamingServer[GetObjectsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetObjects not implemented")
+}
 func (UnimplementedObjectStorageCacheServer) ListObjects(*ListObjectsRequest, grpc.ServerStreamingServer[ListObjectsResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
 }
-func (UnimplementedObjectStorageCacheServer) Authenticate(context.Context, *AuthRequest) (*AuthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
+func (UnimplementedObjectStorageCacheServer) ListObjectVersions(*ListObjectVersionsRequest, grpc.ServerStreamingServer[ListObjectVersionsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ListObjectVersions not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) SelectObjectContent(*SelectObjectContentRequest, grpc.ServerStreamingServer[SelectObjectContentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SelectObjectContent not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) CreateMultipartUpload(context.Context, *CreateMultipartUploadRequest) (*CreateMultipartUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMultipartUpload not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) UploadPart(grpc.ClientStreamingServer[UploadPartRequest, UploadPartResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadPart not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) UploadPartCopy(context.Context, *UploadPartCopyRequest) (*UploadPartCopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadPartCopy not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*CompleteMultipartUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteMultipartUpload not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*AbortMultipartUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortMultipartUpload not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) PutBucketVersioning(context.Context, *PutBucketVersioningRequest) (*PutBucketVersioningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutBucketVersioning not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) GetBucketVersioning(context.Context, *GetBucketVersioningRequest) (*GetBucketVersioningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBucketVersioning not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) PutBucketLifecycle(context.Context, *PutBucketLifecycleRequest) (*PutBucketLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutBucketLifecycle not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) GetBucketLifecycle(context.Context, *GetBucketLifecycleRequest) (*GetBucketLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBucketLifecycle not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) DeleteBucketLifecycle(context.Context, *DeleteBucketLifecycleRequest) (*DeleteBucketLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBucketLifecycle not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) PutObjectTagging(context.Context, *PutObjectTaggingRequest) (*PutObjectTaggingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutObjectTagging not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) GetObjectTagging(context.Context, *GetObjectTaggingRequest) (*GetObjectTaggingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObjectTagging not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) DeleteObjectTagging(context.Context, *DeleteObjectTaggingRequest) (*DeleteObjectTaggingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteObjectTagging not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) PutObjectLockConfiguration(context.Context, *PutObjectLockConfigurationRequest) (*PutObjectLockConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutObjectLockConfiguration not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) GetObjectLockConfiguration(context.Context, *GetObjectLockConfigurationRequest) (*GetObjectLockConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObjectLockConfiguration not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) PutObjectRetention(context.Context, *PutObjectRetentionRequest) (*PutObjectRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutObjectRetention not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) GetObjectRetention(context.Context, *GetObjectRetentionRequest) (*GetObjectRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObjectRetention not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) PutObjectLegalHold(context.Context, *PutObjectLegalHoldRequest) (*PutObjectLegalHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutObjectLegalHold not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) GetObjectLegalHold(context.Context, *GetObjectLegalHoldRequest) (*GetObjectLegalHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetObjectLegalHold not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) PutBucketPolicy(context.Context, *PutBucketPolicyRequest) (*PutBucketPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutBucketPolicy not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) GetBucketPolicy(context.Context, *GetBucketPolicyRequest) (*GetBucketPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBucketPolicy not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) DeleteBucketPolicy(context.Context, *DeleteBucketPolicyRequest) (*DeleteBucketPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBucketPolicy not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) PutBucketEncryption(context.Context, *PutBucketEncryptionRequest) (*PutBucketEncryptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutBucketEncryption not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) GetBucketEncryption(context.Context, *GetBucketEncryptionRequest) (*GetBucketEncryptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBucketEncryption not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) DeleteBucketEncryption(context.Context, *DeleteBucketEncryptionRequest) (*DeleteBucketEncryptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBucketEncryption not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) Authenticate(context.Context, *AuthRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authenticate not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) RotateKey(context.Context, *RotateKeyRequest) (*RotateKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateKey not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) ShareBucket(context.Context, *ShareBucketRequest) (*ShareBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareBucket not implemented")
+}
+func (UnimplementedObjectStorageCacheServer) mustEmbedUnimplementedObjectStorageCacheServer() {}
+func (UnimplementedObjectStorageCacheServer) testEmbeddedByValue()                            {}
+
+// UnsafeObjectStorageCacheServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ObjectStorageCacheServer will
+// result in compilation errors.
+type UnsafeObjectStorageCacheServer interface {
+	mustEmbedUnimplementedObjectStorageCacheServer()
+}
+
+func RegisterObjectStorageCacheServer(s grpc.ServiceRegistrar, srv ObjectStorageCacheServer) {
+	// If the following call pancis, it indicates UnimplementedObjectStorageCacheServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ObjectStorageCache_ServiceDesc, srv)
+}
+
+func _ObjectStorageCache_CreateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).CreateBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_CreateBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).CreateBucket(ctx, req.(*CreateBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStorageCache_DeleteBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).DeleteBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_DeleteBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).DeleteBucket(ctx, req.(*DeleteBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStorageCache_ListBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBucketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).ListBuckets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_ListBuckets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).ListBuckets(ctx, req.(*ListBucketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStorageCache_HeadBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeadBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).HeadBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_HeadBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).HeadBucket(ctx, req.(*HeadBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStorageCache_PutObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ObjectStorageCacheServer).PutObject(&grpc.GenericServerStream[PutObjectRequest, PutObjectResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ObjectStorageCache_PutObjectServer = grpc.ClientStreamingServer[PutObjectRequest, PutObjectResponse]
+
+func _ObjectStorageCache_GetObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetObjectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ObjectStorageCacheServer).GetObject(m, &grpc.GenericServerStream[GetObjectRequest, GetObjectResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ObjectStorageCache_GetObjectServer = grpc.ServerStreamingServer[GetObjectResponse]
+
+func _ObjectStorageCache_DeleteObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).DeleteObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_DeleteObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).DeleteObject(ctx, req.(*DeleteObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStorageCache_DeleteObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).DeleteObjects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_DeleteObjects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).DeleteObjects(ctx, req.(*DeleteObjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStorageCache_CopyObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).CopyObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_CopyObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).CopyObject(ctx, req.(*CopyObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStorageCache_HeadObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeadObjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).HeadObject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_HeadObject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).HeadObject(ctx, req.(*HeadObjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStorageCache_HeadObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(HeadObjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ObjectStorageCacheServer).HeadObjects(m, &grpc.GenericServerStream[HeadObjectsRequest, HeadObjectsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ObjectStorageCache_HeadObjectsServer = grpc.ServerStreamingServer[HeadObjectsResponse]
+
+func _ObjectStorageCache_GetObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetObjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ObjectStorageCacheServer).GetObjects(m, &grpc.GenericServerStream[GetObjectsRequest, GetObjectsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ObjectStorageCache_GetObjectsServer = grpc.ServerStreamingServer[GetObjectsResponse]
+
+func _ObjectStorageCache_ListObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListObjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ObjectStorageCacheServer).ListObjects(m, &grpc.GenericServerStream[ListObjectsRequest, ListObjectsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ObjectStorageCache_ListObjectsServer = grpc.ServerStreamingServer[ListObjectsResponse]
+
+func _ObjectStorageCache_ListObjectVersions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListObjectVersionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ObjectStorageCacheServer).ListObjectVersions(m, &grpc.GenericServerStream[ListObjectVersionsRequest, ListObjectVersionsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ObjectStorageCache_ListObjectVersionsServer = grpc.ServerStreamingServer[ListObjectVersionsResponse]
+
+func _ObjectStorageCache_SelectObjectContent_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SelectObjectContentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ObjectStorageCacheServer).SelectObjectContent(m, &grpc.GenericServerStream[SelectObjectContentRequest, SelectObjectContentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ObjectStorageCache_SelectObjectContentServer = grpc.ServerStreamingServer[SelectObjectContentResponse]
+
+func _ObjectStorageCache_CreateMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).CreateMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_CreateMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).CreateMultipartUpload(ctx, req.(*CreateMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStorageCache_UploadPart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ObjectStorageCacheServer).UploadPart(&grpc.GenericServerStream[UploadPartRequest, UploadPartResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ObjectStorageCache_UploadPartServer = grpc.ClientStreamingServer[UploadPartRequest, UploadPartResponse]
+
+func _ObjectStorageCache_UploadPartCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadPartCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).UploadPartCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_UploadPartCopy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).UploadPartCopy(ctx, req.(*UploadPartCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStorageCache_CompleteMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).CompleteMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_CompleteMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).CompleteMultipartUpload(ctx, req.(*CompleteMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStorageCache_AbortMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).AbortMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_AbortMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).AbortMultipartUpload(ctx, req.(*AbortMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStorageCache_PutBucketVersioning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutBucketVersioningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).PutBucketVersioning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_PutBucketVersioning_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).PutBucketVersioning(ctx, req.(*PutBucketVersioningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ObjectStorageCache_GetBucketVersioning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketVersioningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).GetBucketVersioning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_GetBucketVersioning_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).GetBucketVersioning(ctx, req.(*GetBucketVersioningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedObjectStorageCacheServer) RotateKey(context.Context, *RotateKeyRequest) (*RotateKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RotateKey not implemented")
+
+func _ObjectStorageCache_PutBucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutBucketLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).PutBucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_PutBucketLifecycle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).PutBucketLifecycle(ctx, req.(*PutBucketLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedObjectStorageCacheServer) ShareBucket(context.Context, *ShareBucketRequest) (*ShareBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShareBucket not implemented")
+
+func _ObjectStorageCache_GetBucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).GetBucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_GetBucketLifecycle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).GetBucketLifecycle(ctx, req.(*GetBucketLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedObjectStorageCacheServer) mustEmbedUnimplementedObjectStorageCacheServer() {}
-func (UnimplementedObjectStorageCacheServer) testEmbeddedByValue()                            {}
 
-// UnsafeObjectStorageCacheServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ObjectStorageCacheServer will
-// result in compilation errors.
-type UnsafeObjectStorageCacheServer interface {
-	mustEmbedUnimplementedObjectStorageCacheServer()
+func _ObjectStorageCache_DeleteBucketLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBucketLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).DeleteBucketLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_DeleteBucketLifecycle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).DeleteBucketLifecycle(ctx, req.(*DeleteBucketLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterObjectStorageCacheServer(s grpc.ServiceRegistrar, srv ObjectStorageCacheServer) {
-	// If the following call pancis, it indicates UnimplementedObjectStorageCacheServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ObjectStorageCache_PutObjectTagging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutObjectTaggingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ObjectStorageCache_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).PutObjectTagging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_PutObjectTagging_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).PutObjectTagging(ctx, req.(*PutObjectTaggingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectStorageCache_CreateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBucketRequest)
+func _ObjectStorageCache_GetObjectTagging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectTaggingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ObjectStorageCacheServer).CreateBucket(ctx, in)
+		return srv.(ObjectStorageCacheServer).GetObjectTagging(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ObjectStorageCache_CreateBucket_FullMethodName,
+		FullMethod: ObjectStorageCache_GetObjectTagging_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectStorageCacheServer).CreateBucket(ctx, req.(*CreateBucketRequest))
+		return srv.(ObjectStorageCacheServer).GetObjectTagging(ctx, req.(*GetObjectTaggingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectStorageCache_DeleteBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteBucketRequest)
+func _ObjectStorageCache_DeleteObjectTagging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteObjectTaggingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ObjectStorageCacheServer).DeleteBucket(ctx, in)
+		return srv.(ObjectStorageCacheServer).DeleteObjectTagging(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ObjectStorageCache_DeleteBucket_FullMethodName,
+		FullMethod: ObjectStorageCache_DeleteObjectTagging_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectStorageCacheServer).DeleteBucket(ctx, req.(*DeleteBucketRequest))
+		return srv.(ObjectStorageCacheServer).DeleteObjectTagging(ctx, req.(*DeleteObjectTaggingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectStorageCache_ListBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBucketsRequest)
+func _ObjectStorageCache_PutObjectLockConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutObjectLockConfigurationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ObjectStorageCacheServer).ListBuckets(ctx, in)
+		return srv.(ObjectStorageCacheServer).PutObjectLockConfiguration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ObjectStorageCache_ListBuckets_FullMethodName,
+		FullMethod: ObjectStorageCache_PutObjectLockConfiguration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectStorageCacheServer).ListBuckets(ctx, req.(*ListBucketsRequest))
+		return srv.(ObjectStorageCacheServer).PutObjectLockConfiguration(ctx, req.(*PutObjectLockConfigurationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectStorageCache_HeadBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HeadBucketRequest)
+func _ObjectStorageCache_GetObjectLockConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectLockConfigurationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ObjectStorageCacheServer).HeadBucket(ctx, in)
+		return srv.(ObjectStorageCacheServer).GetObjectLockConfiguration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ObjectStorageCache_HeadBucket_FullMethodName,
+		FullMethod: ObjectStorageCache_GetObjectLockConfiguration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectStorageCacheServer).HeadBucket(ctx, req.(*HeadBucketRequest))
+		return srv.(ObjectStorageCacheServer).GetObjectLockConfiguration(ctx, req.(*GetObjectLockConfigurationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectStorageCache_PutObject_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(ObjectStorageCacheServer).PutObject(&grpc.GenericServerStream[PutObjectRequest, PutObjectResponse]{ServerStream: stream})
+func _ObjectStorageCache_PutObjectRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutObjectRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).PutObjectRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_PutObjectRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).PutObjectRetention(ctx, req.(*PutObjectRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type ObjectStorageCache_PutObjectServer = grpc.ClientStreamingServer[PutObjectRequest, PutObjectResponse]
+func _ObjectStorageCache_GetObjectRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).GetObjectRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_GetObjectRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).GetObjectRetention(ctx, req.(*GetObjectRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func _ObjectStorageCache_GetObject_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetObjectRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _ObjectStorageCache_PutObjectLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutObjectLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(ObjectStorageCacheServer).GetObject(m, &grpc.GenericServerStream[GetObjectRequest, GetObjectResponse]{ServerStream: stream})
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).PutObjectLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_PutObjectLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).PutObjectLegalHold(ctx, req.(*PutObjectLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type ObjectStorageCache_GetObjectServer = grpc.ServerStreamingServer[GetObjectResponse]
+func _ObjectStorageCache_GetObjectLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetObjectLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).GetObjectLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_GetObjectLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).GetObjectLegalHold(ctx, req.(*GetObjectLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func _ObjectStorageCache_DeleteObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteObjectRequest)
+func _ObjectStorageCache_PutBucketPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutBucketPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ObjectStorageCacheServer).DeleteObject(ctx, in)
+		return srv.(ObjectStorageCacheServer).PutBucketPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ObjectStorageCache_DeleteObject_FullMethodName,
+		FullMethod: ObjectStorageCache_PutBucketPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectStorageCacheServer).DeleteObject(ctx, req.(*DeleteObjectRequest))
+		return srv.(ObjectStorageCacheServer).PutBucketPolicy(ctx, req.(*PutBucketPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectStorageCache_DeleteObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteObjectsRequest)
+func _ObjectStorageCache_GetBucketPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ObjectStorageCacheServer).DeleteObjects(ctx, in)
+		return srv.(ObjectStorageCacheServer).GetBucketPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ObjectStorageCache_DeleteObjects_FullMethodName,
+		FullMethod: ObjectStorageCache_GetBucketPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectStorageCacheServer).DeleteObjects(ctx, req.(*DeleteObjectsRequest))
+		return srv.(ObjectStorageCacheServer).GetBucketPolicy(ctx, req.(*GetBucketPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectStorageCache_CopyObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CopyObjectRequest)
+func _ObjectStorageCache_DeleteBucketPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBucketPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ObjectStorageCacheServer).CopyObject(ctx, in)
+		return srv.(ObjectStorageCacheServer).DeleteBucketPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ObjectStorageCache_CopyObject_FullMethodName,
+		FullMethod: ObjectStorageCache_DeleteBucketPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectStorageCacheServer).CopyObject(ctx, req.(*CopyObjectRequest))
+		return srv.(ObjectStorageCacheServer).DeleteBucketPolicy(ctx, req.(*DeleteBucketPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectStorageCache_HeadObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HeadObjectRequest)
+func _ObjectStorageCache_PutBucketEncryption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutBucketEncryptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ObjectStorageCacheServer).HeadObject(ctx, in)
+		return srv.(ObjectStorageCacheServer).PutBucketEncryption(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ObjectStorageCache_HeadObject_FullMethodName,
+		FullMethod: ObjectStorageCache_PutBucketEncryption_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectStorageCacheServer).HeadObject(ctx, req.(*HeadObjectRequest))
+		return srv.(ObjectStorageCacheServer).PutBucketEncryption(ctx, req.(*PutBucketEncryptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ObjectStorageCache_ListObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListObjectsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _ObjectStorageCache_GetBucketEncryption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketEncryptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(ObjectStorageCacheServer).ListObjects(m, &grpc.GenericServerStream[ListObjectsRequest, ListObjectsResponse]{ServerStream: stream})
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).GetBucketEncryption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_GetBucketEncryption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).GetBucketEncryption(ctx, req.(*GetBucketEncryptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type ObjectStorageCache_ListObjectsServer = grpc.ServerStreamingServer[ListObjectsResponse]
+func _ObjectStorageCache_DeleteBucketEncryption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBucketEncryptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ObjectStorageCacheServer).DeleteBucketEncryption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ObjectStorageCache_DeleteBucketEncryption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ObjectStorageCacheServer).DeleteBucketEncryption(ctx, req.(*DeleteBucketEncryptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
 func _ObjectStorageCache_Authenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AuthRequest)
@@ -587,6 +1587,102 @@ var ObjectStorageCache_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HeadObject",
 			Handler:    _ObjectStorageCache_HeadObject_Handler,
 		},
+		{
+			MethodName: "CreateMultipartUpload",
+			Handler:    _ObjectStorageCache_CreateMultipartUpload_Handler,
+		},
+		{
+			MethodName: "UploadPartCopy",
+			Handler:    _ObjectStorageCache_UploadPartCopy_Handler,
+		},
+		{
+			MethodName: "CompleteMultipartUpload",
+			Handler:    _ObjectStorageCache_CompleteMultipartUpload_Handler,
+		},
+		{
+			MethodName: "AbortMultipartUpload",
+			Handler:    _ObjectStorageCache_AbortMultipartUpload_Handler,
+		},
+		{
+			MethodName: "PutBucketVersioning",
+			Handler:    _ObjectStorageCache_PutBucketVersioning_Handler,
+		},
+		{
+			MethodName: "GetBucketVersioning",
+			Handler:    _ObjectStorageCache_GetBucketVersioning_Handler,
+		},
+		{
+			MethodName: "PutBucketLifecycle",
+			Handler:    _ObjectStorageCache_PutBucketLifecycle_Handler,
+		},
+		{
+			MethodName: "GetBucketLifecycle",
+			Handler:    _ObjectStorageCache_GetBucketLifecycle_Handler,
+		},
+		{
+			MethodName: "DeleteBucketLifecycle",
+			Handler:    _ObjectStorageCache_DeleteBucketLifecycle_Handler,
+		},
+		{
+			MethodName: "PutObjectTagging",
+			Handler:    _ObjectStorageCache_PutObjectTagging_Handler,
+		},
+		{
+			MethodName: "GetObjectTagging",
+			Handler:    _ObjectStorageCache_GetObjectTagging_Handler,
+		},
+		{
+			MethodName: "DeleteObjectTagging",
+			Handler:    _ObjectStorageCache_DeleteObjectTagging_Handler,
+		},
+		{
+			MethodName: "PutObjectLockConfiguration",
+			Handler:    _ObjectStorageCache_PutObjectLockConfiguration_Handler,
+		},
+		{
+			MethodName: "GetObjectLockConfiguration",
+			Handler:    _ObjectStorageCache_GetObjectLockConfiguration_Handler,
+		},
+		{
+			MethodName: "PutObjectRetention",
+			Handler:    _ObjectStorageCache_PutObjectRetention_Handler,
+		},
+		{
+			MethodName: "GetObjectRetention",
+			Handler:    _ObjectStorageCache_GetObjectRetention_Handler,
+		},
+		{
+			MethodName: "PutObjectLegalHold",
+			Handler:    _ObjectStorageCache_PutObjectLegalHold_Handler,
+		},
+		{
+			MethodName: "GetObjectLegalHold",
+			Handler:    _ObjectStorageCache_GetObjectLegalHold_Handler,
+		},
+		{
+			MethodName: "PutBucketPolicy",
+			Handler:    _ObjectStorageCache_PutBucketPolicy_Handler,
+		},
+		{
+			MethodName: "GetBucketPolicy",
+			Handler:    _ObjectStorageCache_GetBucketPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteBucketPolicy",
+			Handler:    _ObjectStorageCache_DeleteBucketPolicy_Handler,
+		},
+		{
+			MethodName: "PutBucketEncryption",
+			Handler:    _ObjectStorageCache_PutBucketEncryption_Handler,
+		},
+		{
+			MethodName: "GetBucketEncryption",
+			Handler:    _ObjectStorageCache_GetBucketEncryption_Handler,
+		},
+		{
+			MethodName: "DeleteBucketEncryption",
+			Handler:    _ObjectStorageCache_DeleteBucketEncryption_Handler,
+		},
 		{
 			MethodName: "Authenticate",
 			Handler:    _ObjectStorageCache_Authenticate_Handler,
@@ -611,11 +1707,36 @@ var ObjectStorageCache_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ObjectStorageCache_GetObject_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "HeadObjects",
+			Handler:       _ObjectStorageCache_HeadObjects_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetObjects",
+			Handler:       _ObjectStorageCache_GetObjects_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListObjects",
 			Handler:       _ObjectStorageCache_ListObjects_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListObjectVersions",
+			Handler:       _ObjectStorageCache_ListObjectVersions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SelectObjectContent",
+			Handler:       _ObjectStorageCache_SelectObjectContent_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadPart",
+			Handler:       _ObjectStorageCache_UploadPart_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "client_storage.proto",
 }
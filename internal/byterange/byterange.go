@@ -0,0 +1,47 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+
+// Package byterange parses the single byte ranges accepted by GetObject, shared by the client's
+// read cache and the S3 gateway.
+package byterange
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parse resolves a "bytes=start-end", "bytes=start-" or "bytes=-suffix" range against an object of
+// the given size. The end is clamped to the last byte and a suffix longer than the object selects all of it.
+// It returns the inclusive start and end offsets and an error if the range is malformed or not satisfiable.
+func Parse(spec string, size int64) (int64, int64, error) {
+	rangeSpec, ok := strings.CutPrefix(spec, "bytes=")
+	if !ok || strings.Contains(rangeSpec, ",") {
+		return 0, 0, fmt.Errorf("invalid range %q", spec)
+	}
+	first, last, ok := strings.Cut(strings.TrimSpace(rangeSpec), "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid range %q", spec)
+	}
+
+	if first == "" {
+		suffix, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || suffix <= 0 || size == 0 {
+			return 0, 0, fmt.Errorf("invalid range %q for object of size %d", spec, size)
+		}
+		return max(size-suffix, 0), size - 1, nil
+	}
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 || start >= size {
+		return 0, 0, fmt.Errorf("invalid range %q for object of size %d", spec, size)
+	}
+	end := size - 1
+	if last != "" {
+		end, err = strconv.ParseInt(last, 10, 64)
+		if err != nil || end < start {
+			return 0, 0, fmt.Errorf("invalid range %q", spec)
+		}
+		end = min(end, size-1)
+	}
+	return start, end, nil
+}
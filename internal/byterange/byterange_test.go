@@ -0,0 +1,37 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+package byterange
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		spec       string
+		size       int64
+		start, end int64
+		ok         bool
+	}{
+		{"bytes=0-9", 100, 0, 9, true},
+		{"bytes=10-", 100, 10, 99, true},
+		{"bytes=-10", 100, 90, 99, true},
+		{"bytes=-200", 100, 0, 99, true},
+		{"bytes=50-500", 100, 50, 99, true},
+		{"bytes=99-99", 100, 99, 99, true},
+		{"bytes=100-", 100, 0, 0, false},
+		{"bytes=9-0", 100, 0, 0, false},
+		{"bytes=-0", 100, 0, 0, false},
+		{"bytes=0-1,5-6", 100, 0, 0, false},
+		{"bytes=a-b", 100, 0, 0, false},
+		{"bytes=5", 100, 0, 0, false},
+		{"items=0-9", 100, 0, 0, false},
+		{"bytes=0-", 0, 0, 0, false},
+		{"bytes=-1", 0, 0, 0, false},
+		{"bytes= 5-9", 100, 5, 9, true},
+	}
+
+	for _, tt := range tests {
+		start, end, err := Parse(tt.spec, tt.size)
+		if ok := err == nil; ok != tt.ok || (ok && (start != tt.start || end != tt.end)) {
+			t.Errorf("Parse(%q, %d) = %d, %d, %v; want %d, %d, ok %v", tt.spec, tt.size, start, end, err, tt.start, tt.end, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 Accelerated Cloud Storage Corporation. All Rights Reserved.
+
+// Package server provides helpers for the server side of the ACS service.
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"strconv"
+	"time"
+
+	pb "github.com/AcceleratedCloudStorage/acs-sdk-go/generated"
+	"github.com/AcceleratedCloudStorage/acs-sdk-go/internal/signing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultSignatureMaxSkew is the largest difference between a request's timestamp and the verifier's clock
+const DefaultSignatureMaxSkew = 5 * time.Minute
+
+// RequestVerifier checks the signatures the client adds to every request.
+// It provides gRPC server interceptors that reject requests whose signature is missing, stale or invalid.
+type RequestVerifier struct {
+	// LookupSecret returns the secret access key for an access key ID
+	LookupSecret func(ctx context.Context, accessKeyID string) (string, error)
+	// MaxSkew is the largest accepted clock difference (default DefaultSignatureMaxSkew)
+	MaxSkew time.Duration
+	// Now returns the current time (default time.Now)
+	Now func() time.Time
+}
+
+// Verify checks the signature in the incoming metadata of ctx against the method and request message.
+// It returns an Unauthenticated status error if the signature does not match.
+func (verifier *RequestVerifier) Verify(ctx context.Context, method string, req any) error {
+	// Authenticate carries the secret itself and is not signed
+	if method == pb.ObjectStorageCache_Authenticate_FullMethodName {
+		return nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing request signature")
+	}
+	accessKeyID, timestamp, signature := firstValue(md, signing.AccessKeyHeader), firstValue(md, signing.TimestampHeader), firstValue(md, signing.SignatureHeader)
+	if accessKeyID == "" || timestamp == "" || signature == "" {
+		return status.Error(codes.Unauthenticated, "missing request signature")
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid signature timestamp %q", timestamp)
+	}
+	now, maxSkew := time.Now(), verifier.MaxSkew
+	if verifier.Now != nil {
+		now = verifier.Now()
+	}
+	if maxSkew <= 0 {
+		maxSkew = DefaultSignatureMaxSkew
+	}
+	if skew := now.Sub(time.Unix(seconds, 0)); skew > maxSkew || skew < -maxSkew {
+		return status.Error(codes.Unauthenticated, "request signature expired")
+	}
+
+	secret, err := verifier.LookupSecret(ctx, accessKeyID)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "unknown access key %q", accessKeyID)
+	}
+
+	bucket, key := signing.Resource(req)
+	expected := signing.Sign(secret, method, timestamp, bucket, key)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return status.Error(codes.Unauthenticated, "request signature does not match")
+	}
+	return nil
+}
+
+// UnaryServerInterceptor returns an interceptor that verifies unary requests before handling them.
+func (verifier *RequestVerifier) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := verifier.Verify(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor that verifies streaming requests.
+// The signature covers the bucket and key of the first message, so it is checked when that message is received.
+func (verifier *RequestVerifier) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &verifiedStream{ServerStream: stream, verifier: verifier, method: info.FullMethod})
+	}
+}
+
+// verifiedStream verifies the request signature against the first message received on the stream.
+type verifiedStream struct {
+	grpc.ServerStream
+	verifier *RequestVerifier
+	method   string
+	verified bool
+}
+
+// RecvMsg receives a message, verifying the signature on the first one.
+func (stream *verifiedStream) RecvMsg(m any) error {
+	if err := stream.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if !stream.verified {
+		if err := stream.verifier.Verify(stream.Context(), stream.method, m); err != nil {
+			return err
+		}
+		stream.verified = true
+	}
+	return nil
+}
+
+// firstValue returns the first metadata value for key, or "".
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}